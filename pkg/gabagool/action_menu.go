@@ -0,0 +1,290 @@
+package gabagool
+
+import (
+	"time"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+type actionMenuController struct {
+	title             string
+	actions           []string
+	selectedIndex     int
+	visibleStartIndex int
+	maxVisible        int
+	inputDelay        time.Duration
+	lastInputTime     time.Time
+	cancelled         bool
+	backdrop          *sdl.Texture
+
+	heldDirections struct {
+		up, down bool
+	}
+	lastRepeatTime time.Time
+	repeatDelay    time.Duration
+	repeatInterval time.Duration
+	hasRepeated    bool
+}
+
+// ActionMenu displays a compact popup menu of actions, such as "Play", "Rename" or "Delete".
+// The user navigates with up/down and confirms with A.
+// Returns the index of the chosen action, or ErrCancelled if the user presses B.
+func ActionMenu(title string, actions []string) (int, error) {
+	defer internal.GetInputProcessor().StartCooldown()
+
+	if len(actions) == 0 {
+		return -1, ErrCancelled
+	}
+
+	window := internal.GetWindow()
+	renderer := window.Renderer
+
+	c := &actionMenuController{
+		title:          title,
+		actions:        actions,
+		inputDelay:     constants.DefaultInputDelay,
+		lastInputTime:  time.Now(),
+		lastRepeatTime: time.Now(),
+		repeatDelay:    150 * time.Millisecond,
+		repeatInterval: 50 * time.Millisecond,
+		backdrop:       captureBackdrop(window),
+	}
+	defer destroyBackdrop(c.backdrop)
+	c.maxVisible = c.calculateMaxVisible(window)
+
+	for {
+		if !c.handleEvents() {
+			break
+		}
+
+		c.handleDirectionalRepeats()
+		c.render(renderer, window)
+	}
+
+	if c.cancelled {
+		return -1, ErrCancelled
+	}
+
+	return c.selectedIndex, nil
+}
+
+func (c *actionMenuController) handleEvents() bool {
+	processor := internal.GetInputProcessor()
+
+	if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
+		switch event.(type) {
+		case *sdl.QuitEvent:
+			c.cancelled = true
+			return false
+
+		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
+			inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
+			if inputEvent == nil {
+				return true
+			}
+
+			if !inputEvent.Pressed {
+				switch inputEvent.Button {
+				case constants.VirtualButtonUp:
+					c.heldDirections.up = false
+					c.hasRepeated = false
+				case constants.VirtualButtonDown:
+					c.heldDirections.down = false
+					c.hasRepeated = false
+				}
+				return true
+			}
+
+			if time.Since(c.lastInputTime) < c.inputDelay {
+				return true
+			}
+			c.lastInputTime = time.Now()
+
+			switch inputEvent.Button {
+			case constants.VirtualButtonUp:
+				c.moveSelection(-1)
+				c.heldDirections.up = true
+				c.heldDirections.down = false
+				c.lastRepeatTime = time.Now()
+			case constants.VirtualButtonDown:
+				c.moveSelection(1)
+				c.heldDirections.down = true
+				c.heldDirections.up = false
+				c.lastRepeatTime = time.Now()
+			case constants.VirtualButtonA, constants.VirtualButtonStart:
+				return false
+			case constants.VirtualButtonB:
+				c.cancelled = true
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (c *actionMenuController) handleDirectionalRepeats() {
+	if !c.heldDirections.up && !c.heldDirections.down {
+		return
+	}
+
+	timeSinceLastRepeat := time.Since(c.lastRepeatTime)
+	if (!c.hasRepeated && timeSinceLastRepeat < c.repeatDelay) || (c.hasRepeated && timeSinceLastRepeat < c.repeatInterval) {
+		return
+	}
+
+	c.hasRepeated = true
+	c.lastRepeatTime = time.Now()
+
+	if c.heldDirections.up {
+		c.moveSelection(-1)
+	} else {
+		c.moveSelection(1)
+	}
+}
+
+func (c *actionMenuController) moveSelection(delta int) {
+	c.selectedIndex = (c.selectedIndex + delta + len(c.actions)) % len(c.actions)
+
+	if c.selectedIndex < c.visibleStartIndex {
+		c.visibleStartIndex = c.selectedIndex
+	} else if c.selectedIndex >= c.visibleStartIndex+c.maxVisible {
+		c.visibleStartIndex = c.selectedIndex - c.maxVisible + 1
+	}
+}
+
+func (c *actionMenuController) rowHeight() int32 {
+	return int32(float32(50) * internal.GetScaleFactor())
+}
+
+func (c *actionMenuController) calculateMaxVisible(window *internal.Window) int {
+	footerHeight := int32(float32(50)*internal.GetScaleFactor()*internal.GetFontScale()) + internal.GetSafeArea().Bottom + 20
+	titleHeight := int32(0)
+	if c.title != "" {
+		titleHeight = int32(internal.Fonts.MediumFont.Height()) + 20
+	}
+
+	available := window.GetHeight() - footerHeight*2 - titleHeight
+	maxVisible := int(available / c.rowHeight())
+	if maxVisible < 1 {
+		maxVisible = 1
+	}
+	return maxVisible
+}
+
+// render draws the menu over a dimmed copy of the screen it was opened from.
+func (c *actionMenuController) render(renderer *sdl.Renderer, window *internal.Window) {
+	internal.ShowModal(renderer, func() {
+		if c.backdrop == nil && window.HasBackground() {
+			window.RenderBackground()
+			return
+		}
+		renderBackdrop(renderer, window, c.backdrop, sdl.Color{R: 0, G: 0, B: 0, A: 255})
+	}, func() {
+		c.renderMenu(renderer, window)
+	})
+
+	internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: c.selectedIndex, TextLength: -1})
+	window.Present()
+}
+
+func (c *actionMenuController) renderMenu(renderer *sdl.Renderer, window *internal.Window) {
+	windowWidth := window.GetWidth()
+	windowHeight := window.GetHeight()
+	scaleFactor := internal.GetScaleFactor()
+	theme := internal.GetTheme()
+
+	titleFont := internal.Fonts.MediumFont
+	font := internal.Fonts.SmallFont
+	padding := int32(float32(20) * scaleFactor)
+	rowHeight := c.rowHeight()
+
+	contentWidth := int32(0)
+	if c.title != "" {
+		if w, _, err := titleFont.SizeUTF8(c.title); err == nil {
+			contentWidth = int32(w)
+		}
+	}
+	for _, action := range c.actions {
+		if w, _, err := font.SizeUTF8(action); err == nil && int32(w)+padding*2 > contentWidth {
+			contentWidth = int32(w) + padding*2
+		}
+	}
+
+	popupWidth := internal.Max32(contentWidth+padding*2, windowWidth*2/5)
+	popupWidth = internal.Min32(popupWidth, windowWidth*4/5)
+
+	visibleCount := min(c.maxVisible, len(c.actions))
+	titleHeight := int32(0)
+	if c.title != "" {
+		titleHeight = int32(titleFont.Height()) + padding/2
+	}
+	popupHeight := padding*2 + titleHeight + int32(visibleCount)*rowHeight
+
+	popupRect := sdl.Rect{
+		X: (windowWidth - popupWidth) / 2,
+		Y: (windowHeight - popupHeight) / 2,
+		W: popupWidth,
+		H: popupHeight,
+	}
+	internal.DrawRoundedRect(renderer, &popupRect, padding, theme.CardBackground)
+
+	currentY := popupRect.Y + padding
+	if c.title != "" {
+		c.renderCenteredText(renderer, titleFont, c.title, theme.TextColor, popupRect.X, popupRect.W, currentY, int32(titleFont.Height()))
+		currentY += titleHeight
+	}
+
+	for i := 0; i < visibleCount; i++ {
+		index := c.visibleStartIndex + i
+		rowY := currentY + int32(i)*rowHeight
+
+		textColor := theme.TextColor
+		if index == c.selectedIndex {
+			highlightRect := sdl.Rect{X: popupRect.X + padding/2, Y: rowY, W: popupRect.W - padding, H: rowHeight}
+			internal.DrawRoundedRect(renderer, &highlightRect, rowHeight/2, theme.HighlightColor)
+			textColor = theme.HighlightedTextColor
+		}
+
+		c.renderCenteredText(renderer, font, c.actions[index], textColor, popupRect.X, popupRect.W, rowY, rowHeight)
+	}
+
+	renderFooter(
+		renderer,
+		internal.Fonts.SmallFont,
+		[]FooterHelpItem{
+			{ButtonName: "B", HelpText: "Cancel"},
+			{ButtonName: "A", HelpText: "Select"},
+		},
+		defaultFooterMargins(),
+		true,
+		false,
+	)
+}
+
+// renderCenteredText draws text horizontally centered within [x, x+width] and vertically centered within [y, y+height].
+func (c *actionMenuController) renderCenteredText(renderer *sdl.Renderer, font *ttf.Font, text string, color sdl.Color, x, width, y, height int32) {
+	surface, err := font.RenderUTF8Blended(text, color)
+	if err != nil {
+		return
+	}
+	defer surface.Free()
+
+	texture, err := renderer.CreateTextureFromSurface(surface)
+	if err != nil {
+		return
+	}
+	defer texture.Destroy()
+
+	maxWidth := width - int32(float32(40)*internal.GetScaleFactor())
+	src := sdl.Rect{X: 0, Y: 0, W: internal.Min32(surface.W, maxWidth), H: surface.H}
+	dst := sdl.Rect{
+		X: x + (width-src.W)/2,
+		Y: y + (height-surface.H)/2,
+		W: src.W,
+		H: surface.H,
+	}
+	renderer.Copy(texture, &src, &dst)
+}
@@ -0,0 +1,187 @@
+package gabagool
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"time"
+	"unsafe"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// minGIFFrameDelay is used for frames that request a 0 or 10ms delay, matching browser behavior
+const minGIFFrameDelay = 100 * time.Millisecond
+
+// maxGIFFrames caps how many frames of a GIF are played, so a very long animation can't exhaust memory.
+// Frames past the cap are dropped and the animation loops early.
+const maxGIFFrames = 500
+
+// frameSource provides the frames of an animatedImage one at a time, as they come up.
+type frameSource interface {
+	// frame returns the texture for frame index. It stays owned by the source.
+	// Frames are requested in order, wrapping from the last back to 0.
+	frame(index int) (*sdl.Texture, error)
+	destroy()
+}
+
+// animatedImage plays the frames of an animated GIF or similar source, advancing them over time.
+type animatedImage struct {
+	source      frameSource
+	shown       *sdl.Texture
+	delays      []time.Duration
+	current     int
+	lastAdvance time.Time
+	width       int32
+	height      int32
+}
+
+// newAnimatedImage starts an animation on the first frame of source.
+func newAnimatedImage(source frameSource, delays []time.Duration, width, height int32) (*animatedImage, error) {
+	shown, err := source.frame(0)
+	if err != nil {
+		source.destroy()
+		return nil, err
+	}
+
+	return &animatedImage{
+		source:      source,
+		shown:       shown,
+		delays:      delays,
+		width:       width,
+		height:      height,
+		lastAdvance: time.Now(),
+	}, nil
+}
+
+// isGIF checks if the data is GIF format
+func isGIF(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("GIF87a")) || bytes.HasPrefix(data, []byte("GIF89a"))
+}
+
+// gifFrames composites a GIF's frames onto a full-size canvas as they come up and uploads it to one streaming
+// texture, so only the compressed frames and a single canvas stay in memory.
+// Compositing keeps partial frames and disposal methods rendering correctly.
+type gifFrames struct {
+	decoded  *gif.GIF
+	canvas   *image.RGBA
+	saved    *image.RGBA // the canvas before a frame that is disposed to the previous one
+	texture  *sdl.Texture
+	disposed int // the frame whose disposal is still to be applied, -1 for none
+}
+
+// loadAnimatedGIF decodes a GIF into an animation whose frames are composited as they are shown.
+func loadAnimatedGIF(renderer *sdl.Renderer, data []byte) (*animatedImage, error) {
+	decoded, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode GIF: %w", err)
+	}
+
+	if len(decoded.Image) == 0 {
+		return nil, fmt.Errorf("GIF contains no frames")
+	}
+	if len(decoded.Image) > maxGIFFrames {
+		decoded.Image = decoded.Image[:maxGIFFrames]
+	}
+
+	bounds := image.Rect(0, 0, decoded.Config.Width, decoded.Config.Height)
+	if bounds.Empty() {
+		bounds = decoded.Image[0].Bounds()
+	}
+
+	texture, err := renderer.CreateTexture(uint32(sdl.PIXELFORMAT_RGBA32), sdl.TEXTUREACCESS_STREAMING, int32(bounds.Dx()), int32(bounds.Dy()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GIF texture: %w", err)
+	}
+	texture.SetBlendMode(sdl.BLENDMODE_BLEND)
+
+	delays := make([]time.Duration, len(decoded.Image))
+	for i := range delays {
+		delays[i] = minGIFFrameDelay
+		// GIF delays are in hundredths of a second
+		if i < len(decoded.Delay) && decoded.Delay[i] > 1 {
+			delays[i] = time.Duration(decoded.Delay[i]) * 10 * time.Millisecond
+		}
+	}
+
+	source := &gifFrames{
+		decoded:  decoded,
+		canvas:   image.NewRGBA(bounds),
+		texture:  texture,
+		disposed: -1,
+	}
+	return newAnimatedImage(source, delays, int32(bounds.Dx()), int32(bounds.Dy()))
+}
+
+func (g *gifFrames) disposal(index int) byte {
+	if index < len(g.decoded.Disposal) {
+		return g.decoded.Disposal[index]
+	}
+	return 0
+}
+
+func (g *gifFrames) frame(index int) (*sdl.Texture, error) {
+	if index == 0 {
+		// Looping starts over from an empty canvas
+		draw.Draw(g.canvas, g.canvas.Bounds(), image.Transparent, image.Point{}, draw.Src)
+	} else if g.disposed >= 0 {
+		switch g.disposal(g.disposed) {
+		case gif.DisposalBackground:
+			draw.Draw(g.canvas, g.decoded.Image[g.disposed].Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			copy(g.canvas.Pix, g.saved.Pix)
+		}
+	}
+
+	if g.disposal(index) == gif.DisposalPrevious {
+		if g.saved == nil {
+			g.saved = image.NewRGBA(g.canvas.Bounds())
+		}
+		copy(g.saved.Pix, g.canvas.Pix)
+	}
+
+	frame := g.decoded.Image[index]
+	draw.Draw(g.canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+	g.disposed = index
+
+	if err := g.texture.Update(nil, unsafe.Pointer(&g.canvas.Pix[0]), g.canvas.Stride); err != nil {
+		return nil, fmt.Errorf("failed to upload GIF frame: %w", err)
+	}
+	return g.texture, nil
+}
+
+func (g *gifFrames) destroy() {
+	g.texture.Destroy()
+}
+
+// update advances to the next frame once the current frame's delay has elapsed.
+// A frame that fails to load is skipped, leaving the previous one on screen.
+func (a *animatedImage) update() {
+	if len(a.delays) < 2 {
+		return
+	}
+
+	if time.Since(a.lastAdvance) >= a.delays[a.current] {
+		a.current = (a.current + 1) % len(a.delays)
+		a.lastAdvance = time.Now()
+		if shown, err := a.source.frame(a.current); err == nil {
+			a.shown = shown
+		}
+	}
+}
+
+// texture returns the frame that should currently be displayed.
+func (a *animatedImage) texture() *sdl.Texture {
+	return a.shown
+}
+
+func (a *animatedImage) destroy() {
+	if a.source != nil {
+		a.source.destroy()
+	}
+	a.source = nil
+	a.shown = nil
+	a.delays = nil
+}
@@ -181,7 +181,7 @@ func (h *ColorPicker) draw(renderer *sdl.Renderer) {
 		}
 	}
 
-	renderStatusBar(renderer, internal.Fonts.SmallFont, h.StatusBar, internal.UniformPadding(20))
+	renderStatusBar(renderer, internal.Fonts.SmallFont, h.StatusBar, internal.UniformPadding(20).WithSafeArea())
 }
 
 func (h *ColorPicker) handleKeyPress(key sdl.Keycode) bool {
@@ -99,6 +99,20 @@ func ClearCombos() {
 	internal.GetInputProcessor().ClearCombos()
 }
 
+// ComboEvents returns a channel that delivers combo events, for use in a select alongside other channels.
+// It receives the same events as ProcessComboEvent, independently, so use one or the other.
+//
+// Example:
+//
+//	select {
+//	case evt := <-gabagool.ComboEvents():
+//	    fmt.Println("Combo:", evt.ComboID)
+//	case <-done:
+//	}
+func ComboEvents() <-chan *ComboEvent {
+	return internal.GetInputProcessor().ComboEvents()
+}
+
 // ProcessComboEvent returns the next queued combo event, or nil if none are pending.
 // Note: If you're using callbacks (OnTrigger/OnRelease), you typically don't need
 // to call this function as the callbacks are invoked automatically.
@@ -1,6 +1,8 @@
 package gabagool
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
@@ -14,6 +16,11 @@ type MessageOptions struct {
 	ConfirmButton constants.VirtualButton
 	CancelButton  constants.VirtualButton
 	StatusBar     StatusBarOptions
+	// AutoDismissAfter closes a ConfirmationMessage on its own once it elapses, showing a countdown until then.
+	// Pressing any button stops the countdown.
+	AutoDismissAfter time.Duration
+	// AutoDismissResult is whether an auto-dismissed message counts as confirmed or cancelled
+	AutoDismissResult bool
 }
 
 // ConfirmationResult represents the result of a confirmation message.
@@ -38,11 +45,41 @@ type confirmationMessageSettings struct {
 	FooterTextColor  sdl.Color
 	InputDelay       time.Duration
 	StatusBar        StatusBarOptions
+	Backdrop         *sdl.Texture // The screen the message was opened from, shown dimmed behind it
+}
+
+// autoDismissTimer counts down to a message closing itself. A zero deadline means the countdown is off.
+type autoDismissTimer struct {
+	deadline time.Time
+}
+
+func newAutoDismissTimer(duration time.Duration) *autoDismissTimer {
+	if duration <= 0 {
+		return &autoDismissTimer{}
+	}
+	return &autoDismissTimer{deadline: time.Now().Add(duration)}
+}
+
+func (t *autoDismissTimer) active() bool {
+	return !t.deadline.IsZero()
+}
+
+func (t *autoDismissTimer) expired() bool {
+	return t.active() && !time.Now().Before(t.deadline)
+}
+
+func (t *autoDismissTimer) cancel() {
+	t.deadline = time.Time{}
+}
+
+// secondsLeft rounds up so the countdown shows 1 until the very end.
+func (t *autoDismissTimer) secondsLeft() int {
+	return int(math.Ceil(time.Until(t.deadline).Seconds()))
 }
 
 func defaultMessageSettings(message string) confirmationMessageSettings {
 	return confirmationMessageSettings{
-		Margins:          internal.UniformPadding(20),
+		Margins:          internal.UniformPadding(20).WithSafeArea(),
 		MessageText:      message,
 		MessageAlign:     constants.TextAlignCenter,
 		ButtonSpacing:    20,
@@ -60,30 +97,18 @@ func defaultMessageSettings(message string) confirmationMessageSettings {
 // ConfirmationMessage displays a confirmation dialog.
 // Returns ErrCancelled if the user cancels or presses the cancel button.
 func ConfirmationMessage(message string, footerHelpItems []FooterHelpItem, options MessageOptions) (*ConfirmationResult, error) {
+	defer internal.GetInputProcessor().StartCooldown()
+
 	window := internal.GetWindow()
 	renderer := window.Renderer
 
-	settings := defaultMessageSettings(message)
-	settings.FooterHelpItems = footerHelpItems
-
-	if options.ImagePath != "" {
-		settings.ImagePath = options.ImagePath
-		settings.MaxImageWidth = int32(float64(window.GetWidth()) / 1.75)
-		settings.MaxImageHeight = int32(float64(window.GetHeight()) / 1.75)
-	}
-
-	if options.ConfirmButton != constants.VirtualButtonUnassigned {
-		settings.ConfirmButton = options.ConfirmButton
-	}
-
-	if options.CancelButton != constants.VirtualButtonUnassigned {
-		settings.CancelButton = options.CancelButton
-	}
-
-	settings.StatusBar = options.StatusBar
+	settings := messageSettingsFromOptions(window, message, footerHelpItems, options)
+	settings.Backdrop = captureBackdrop(window)
+	defer destroyBackdrop(settings.Backdrop)
 
 	result := ConfirmationResult{Confirmed: false}
 	lastInputTime := time.Now()
+	timer := newAutoDismissTimer(options.AutoDismissAfter)
 
 	imageTexture, imageRect := loadAndPrepareImage(renderer, settings)
 	defer func() {
@@ -93,11 +118,16 @@ func ConfirmationMessage(message string, footerHelpItems []FooterHelpItem, optio
 	}()
 
 	for {
-		if !handleEvents(&result, &lastInputTime, settings) {
+		if !handleEvents(&result, &lastInputTime, settings, timer) {
+			break
+		}
+
+		if timer.expired() {
+			result.Confirmed = options.AutoDismissResult
 			break
 		}
 
-		renderFrame(renderer, window, settings, imageTexture, imageRect)
+		renderFrame(renderer, window, settings, imageTexture, imageRect, timer)
 	}
 
 	if !result.Confirmed {
@@ -106,6 +136,29 @@ func ConfirmationMessage(message string, footerHelpItems []FooterHelpItem, optio
 	return &result, nil
 }
 
+func messageSettingsFromOptions(window *internal.Window, message string, footerHelpItems []FooterHelpItem, options MessageOptions) confirmationMessageSettings {
+	settings := defaultMessageSettings(message)
+	settings.FooterHelpItems = footerHelpItems
+
+	if options.ImagePath != "" {
+		settings.ImagePath = options.ImagePath
+		settings.MaxImageWidth = int32(float64(window.GetWidth()) / 1.75)
+		settings.MaxImageHeight = int32(float64(window.GetHeight()) / 1.75)
+	}
+
+	if options.ConfirmButton != constants.VirtualButtonUnassigned {
+		settings.ConfirmButton = options.ConfirmButton
+	}
+
+	if options.CancelButton != constants.VirtualButtonUnassigned {
+		settings.CancelButton = options.CancelButton
+	}
+
+	settings.StatusBar = options.StatusBar
+
+	return settings
+}
+
 func loadAndPrepareImage(renderer *sdl.Renderer, settings confirmationMessageSettings) (*sdl.Texture, sdl.Rect) {
 	if settings.ImagePath == "" {
 		return nil, sdl.Rect{}
@@ -138,27 +191,33 @@ func loadAndPrepareImage(renderer *sdl.Renderer, settings confirmationMessageSet
 	}
 }
 
-func handleEvents(result *ConfirmationResult, lastInputTime *time.Time, settings confirmationMessageSettings) bool {
+func handleEvents(result *ConfirmationResult, lastInputTime *time.Time, settings confirmationMessageSettings, timer *autoDismissTimer) bool {
 	processor := internal.GetInputProcessor()
 
-	if event := sdl.WaitEventTimeout(16); event != nil {
+	if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
 		switch event.(type) {
 		case *sdl.QuitEvent:
 			result.Confirmed = false
 			return false
 
-		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent:
+		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
 			inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
 			if inputEvent == nil || !inputEvent.Pressed {
 				return true
 			}
 
+			timer.cancel()
+
 			if !isInputAllowed(*lastInputTime, settings.InputDelay) {
 				return true
 			}
 
 			*lastInputTime = time.Now()
 
+			if footerButtonDisabled(settings.FooterHelpItems, inputEvent.Button) {
+				return true
+			}
+
 			switch inputEvent.Button {
 			case settings.ConfirmButton, constants.VirtualButtonStart:
 				result.Confirmed = true
@@ -176,22 +235,64 @@ func isInputAllowed(lastInputTime time.Time, inputDelay time.Duration) bool {
 	return time.Since(lastInputTime) >= inputDelay
 }
 
-func renderFrame(renderer *sdl.Renderer, window *internal.Window, settings confirmationMessageSettings, imageTexture *sdl.Texture, imageRect sdl.Rect) {
-	renderer.SetDrawColor(
-		settings.BackgroundColor.R,
-		settings.BackgroundColor.G,
-		settings.BackgroundColor.B,
-		settings.BackgroundColor.A)
+func renderFrame(renderer *sdl.Renderer, window *internal.Window, settings confirmationMessageSettings, imageTexture *sdl.Texture, imageRect sdl.Rect, timer *autoDismissTimer) {
+	internal.ShowModal(renderer, func() {
+		renderBackdrop(renderer, window, settings.Backdrop, settings.BackgroundColor)
+	}, func() {
+		if timer.active() {
+			font := internal.Fonts.MediumFont
+			gap := int32(float32(20) * internal.GetScaleFactor())
+
+			y := renderMessageBody(renderer, window, settings, imageTexture, imageRect, gap+int32(font.Height()))
+			if texture := renderText(renderer, fmt.Sprintf("%d…", timer.secondsLeft()), font, internal.GetTheme().HintColor); texture != nil {
+				_, _, w, h, _ := texture.Query()
+				renderer.Copy(texture, nil, &sdl.Rect{X: (window.GetWidth() - w) / 2, Y: y + gap, W: w, H: h})
+				texture.Destroy()
+			}
+		} else {
+			renderMessageBody(renderer, window, settings, imageTexture, imageRect, 0)
+		}
+
+		renderMessageChrome(renderer, settings)
+	})
+	internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: -1, TextLength: -1})
+	window.Present()
+}
+
+// captureBackdrop snapshots the last frame of the screen a message is opened from, or returns nil if there is none.
+func captureBackdrop(window *internal.Window) *sdl.Texture {
+	texture, err := window.CaptureTexture()
+	if err != nil {
+		internal.GetInternalLogger().Debug("Failed to capture message backdrop", "error", err)
+		return nil
+	}
+	return texture
+}
+
+func destroyBackdrop(backdrop *sdl.Texture) {
+	if backdrop != nil {
+		backdrop.Destroy()
+	}
+}
+
+// renderBackdrop draws the screen a message was opened from, or clears to color if there is none.
+func renderBackdrop(renderer *sdl.Renderer, window *internal.Window, backdrop *sdl.Texture, color sdl.Color) {
+	renderer.SetDrawColor(color.R, color.G, color.B, color.A)
 	renderer.Clear()
 
+	if backdrop != nil {
+		renderer.Copy(backdrop, nil, &sdl.Rect{X: 0, Y: 0, W: window.GetWidth(), H: window.GetHeight()})
+	}
+}
+
+// renderMessageBody draws the image and message text, vertically centered together with extraHeight pixels
+// of content that the caller draws below them. Returns the Y position just below the message.
+func renderMessageBody(renderer *sdl.Renderer, window *internal.Window, settings confirmationMessageSettings, imageTexture *sdl.Texture, imageRect sdl.Rect, extraHeight int32) int32 {
 	windowWidth := window.GetWidth()
 	windowHeight := window.GetHeight()
-	responsiveMaxWidth := int32(float64(windowWidth) * 0.75)
-	if responsiveMaxWidth > 800 {
-		responsiveMaxWidth = 800
-	}
+	responsiveMaxWidth := messageMaxWidth(windowWidth)
 
-	contentHeight := calculateContentHeight(settings, imageRect)
+	contentHeight := calculateContentHeight(settings, imageRect) + extraHeight
 	startY := (windowHeight - contentHeight) / 2
 
 	if imageTexture != nil {
@@ -212,20 +313,31 @@ func renderFrame(renderer *sdl.Renderer, window *internal.Window, settings confi
 			startY,
 			settings.MessageTextColor,
 			constants.TextAlignCenter)
+		startY += calculateMultilineTextHeight(settings.MessageText, internal.Fonts.SmallFont, responsiveMaxWidth)
 	}
 
+	return startY
+}
+
+func renderMessageChrome(renderer *sdl.Renderer, settings confirmationMessageSettings) {
 	renderStatusBar(renderer, internal.Fonts.SmallFont, settings.StatusBar, settings.Margins)
 
 	renderFooter(
 		renderer,
 		internal.Fonts.SmallFont,
 		settings.FooterHelpItems,
-		settings.Margins.Bottom,
+		settings.Margins,
 		false,
 		true,
 	)
+}
 
-	renderer.Present()
+func messageMaxWidth(windowWidth int32) int32 {
+	maxWidth := int32(float64(windowWidth) * 0.75)
+	if maxWidth > 800 {
+		maxWidth = 800
+	}
+	return maxWidth
 }
 
 func calculateContentHeight(settings confirmationMessageSettings, imageRect sdl.Rect) int32 {
@@ -37,6 +37,8 @@ const (
 	VirtualButtonVolumeUp
 	VirtualButtonVolumeDown
 	VirtualButtonPower
+	VirtualButtonL3
+	VirtualButtonR3
 )
 
 func (vb VirtualButton) GetName() string {
@@ -83,6 +85,10 @@ func (vb VirtualButton) GetName() string {
 		return "VolumeDown"
 	case VirtualButtonPower:
 		return "Power"
+	case VirtualButtonL3:
+		return "L3"
+	case VirtualButtonR3:
+		return "R3"
 	default:
 		return "Unknown"
 	}
@@ -96,6 +102,22 @@ const (
 	TextAlignRight
 )
 
+type VerticalAlign int
+
+const (
+	VerticalAlignTop VerticalAlign = iota
+	VerticalAlignCenter
+)
+
+// TextOverflow controls how text that doesn't fit its row is shown.
+type TextOverflow int
+
+const (
+	TextOverflowAuto     TextOverflow = iota // Scroll the focused item, truncate the rest
+	TextOverflowTruncate                     // Always truncate with an ellipsis
+	TextOverflowScroll                       // Scroll every item that overflows
+)
+
 const (
 	DefaultInputDelay         = 20 * time.Millisecond
 	DefaultTitleSpacing int32 = 5
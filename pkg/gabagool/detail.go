@@ -1,6 +1,8 @@
 package gabagool
 
 import (
+	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -21,6 +23,8 @@ const (
 	SectionTypeInfo
 	SectionTypeDescription
 	SectionTypeImage
+	SectionTypeVideo
+	SectionTypeTable
 )
 
 type Section struct {
@@ -32,11 +36,28 @@ type Section struct {
 	MaxWidth    int32
 	MaxHeight   int32
 	Alignment   int
+	VideoPath   string // For SectionTypeVideo: an animated GIF or a directory of frame images
+	FrameRate   int    // For SectionTypeVideo: frames per second; 0 keeps a GIF's own timing
+
+	// For SectionTypeTable: rows of cells, the first row being the column headers.
+	// ColumnWidths sets each column's width; missing columns share the remaining width evenly.
+	TableData    [][]string
+	ColumnWidths []int32
+
+	// Collapsible sections can be focused with left/right and folded with A. InitiallyCollapsed starts them folded.
+	Collapsible        bool
+	InitiallyCollapsed bool
 }
 
 type DetailScreenOptions struct {
-	Sections            []Section
-	TitleColor          sdl.Color
+	Sections   []Section
+	TitleColor sdl.Color
+
+	// TitleAlign positions the title. DefaultInfoScreenOptions centers it, as the title always was before this
+	// option existed; its zero value is TextAlignLeft, so options built without DefaultInfoScreenOptions must set
+	// TextAlignCenter to keep a centered title.
+	TitleAlign constants.TextAlign
+
 	MetadataColor       sdl.Color
 	DescriptionColor    sdl.Color
 	BackgroundColor     sdl.Color
@@ -47,6 +68,18 @@ type DetailScreenOptions struct {
 	ShowScrollbar       bool
 	ShowThemeBackground bool
 	StatusBar           StatusBarOptions
+
+	// ShowTableOfContents pins a strip of section titles to the top of the screen. L1/R1 jump between sections,
+	// as do left/right when no slideshow is on screen.
+	ShowTableOfContents bool
+
+	// HelpButton toggles a help overlay. If HelpText is empty, the lines describe the screen's default controls.
+	HelpButton   constants.VirtualButton
+	HelpTitle    string
+	HelpText     []string
+	HelpExitText string
+
+	ThemeOverride *Theme // Renders this screen with its own theme instead of the global one
 }
 
 // DetailScreenResult represents the result of the DetailScreen component.
@@ -67,6 +100,7 @@ type detailScreenState struct {
 	lastInputTime          time.Time
 	inputDelay             time.Duration
 	slideshowStates        map[int]slideshowState
+	videoStates            map[int]*videoState
 	textureCache           *internal.TextureCache
 	titleTexture           *sdl.Texture
 	sectionTitleTextures   []*sdl.Texture
@@ -79,11 +113,19 @@ type detailScreenState struct {
 	activeSlideshow        int
 	lastDirectionPressTime time.Time
 	directionTimeout       time.Duration
+	helpOverlay            *helpOverlay
+	showingHelp            bool
+	collapsedSections      map[int]bool
+	focusedSection         int
+	sectionOffsets         []int32
+	currentSection         int
+	sectionJumped          bool
 }
 
 type slideshowState struct {
 	currentIndex int
 	textures     []*sdl.Texture
+	animations   []*animatedImage // nil entries for static images
 	dimensions   []sdl.Rect
 }
 
@@ -91,6 +133,7 @@ func DefaultInfoScreenOptions() DetailScreenOptions {
 	return DetailScreenOptions{
 		Sections:         []Section{},
 		TitleColor:       sdl.Color{R: 255, G: 255, B: 255, A: 255},
+		TitleAlign:       constants.TextAlignCenter,
 		MetadataColor:    sdl.Color{R: 220, G: 220, B: 220, A: 255},
 		DescriptionColor: sdl.Color{R: 200, G: 200, B: 200, A: 255},
 		BackgroundColor:  sdl.Color{R: 0, G: 0, B: 0, A: 255},
@@ -126,6 +169,29 @@ func NewDescriptionSection(title string, description string) Section {
 	}
 }
 
+// NewVideoSection creates a section that loops a short clip, such as a game preview.
+// videoPath is an animated GIF or a directory of frame images; frameRate 0 keeps the GIF's own timing.
+func NewVideoSection(title string, videoPath string, maxWidth, maxHeight int32, frameRate int) Section {
+	return Section{
+		Type:      SectionTypeVideo,
+		Title:     title,
+		VideoPath: videoPath,
+		MaxWidth:  maxWidth,
+		MaxHeight: maxHeight,
+		FrameRate: frameRate,
+	}
+}
+
+// NewTableSection creates a section that lays out rows as a grid. The first row holds the column headers.
+func NewTableSection(title string, rows [][]string, columnWidths []int32) Section {
+	return Section{
+		Type:         SectionTypeTable,
+		Title:        title,
+		TableData:    rows,
+		ColumnWidths: columnWidths,
+	}
+}
+
 func NewImageSection(title string, imagePath string, maxWidth, maxHeight int32, alignment constants.TextAlign) Section {
 	return Section{
 		Type:       SectionTypeImage,
@@ -139,6 +205,8 @@ func NewImageSection(title string, imagePath string, maxWidth, maxHeight int32,
 
 // DetailScreen displays a scrollable detail screen with sections.
 func DetailScreen(title string, options DetailScreenOptions, footerHelpItems []FooterHelpItem) (*DetailScreenResult, error) {
+	defer internal.GetInputProcessor().StartCooldown()
+
 	state := initializeDetailScreenState(title, options, footerHelpItems)
 	defer state.cleanup()
 
@@ -166,23 +234,42 @@ func initializeDetailScreenState(title string, options DetailScreenOptions, foot
 		lastInputTime:         time.Now(),
 		inputDelay:            constants.DefaultInputDelay,
 		slideshowStates:       make(map[int]slideshowState),
+		videoStates:           make(map[int]*videoState),
 		textureCache:          internal.NewTextureCache(),
 		metadataLabelTextures: make(map[int][]*sdl.Texture),
 		repeatDelay:           time.Millisecond * 150,
 		repeatInterval:        time.Millisecond * 50,
 		result:                DetailScreenResult{Action: DetailActionNone},
 		directionTimeout:      time.Millisecond * 200,
+		collapsedSections:     make(map[int]bool),
+		focusedSection:        -1,
+		sectionOffsets:        make([]int32, len(options.Sections)),
+	}
+
+	for i, section := range options.Sections {
+		if section.Collapsible && section.InitiallyCollapsed {
+			state.collapsedSections[i] = true
+		}
+	}
+
+	if options.HelpButton != constants.VirtualButtonUnassigned {
+		helpText := options.HelpText
+		if len(helpText) == 0 {
+			helpText = state.defaultHelpLines()
+		}
+		state.helpOverlay = newHelpOverlay(options.HelpTitle, helpText, options.HelpExitText)
 	}
 
 	state.initializeImageDefaults()
 	state.loadTextures(title)
 	state.initializeSlideshows()
+	state.initializeVideos()
 
 	return state
 }
 
 func (s *detailScreenState) initializeImageDefaults() {
-	footerHeight := int32(30)
+	footerHeight := int32(float32(30) * internal.GetFontScale())
 	safeAreaHeight := s.window.GetHeight() - footerHeight
 
 	if s.options.MaxImageHeight == 0 {
@@ -223,6 +310,32 @@ func (s *detailScreenState) initializeSlideshows() {
 	}
 }
 
+func (s *detailScreenState) initializeVideos() {
+	for i, section := range s.options.Sections {
+		if section.Type != SectionTypeVideo {
+			continue
+		}
+
+		video, err := loadVideo(s.renderer, section.VideoPath, section.FrameRate)
+		if err != nil {
+			internal.GetInternalLogger().Error("Failed to load video section", "path", section.VideoPath, "error", err)
+			continue
+		}
+
+		maxWidth, maxHeight := section.MaxWidth, section.MaxHeight
+		if maxWidth == 0 {
+			maxWidth = s.options.MaxImageWidth
+		}
+		if maxHeight == 0 {
+			maxHeight = s.options.MaxImageHeight
+		}
+
+		videoW, videoH := s.calculateScaledDimensions(video.width, video.height, maxWidth, maxHeight)
+		video.dimensions = sdl.Rect{X: s.calculateImageX(videoW, section), W: videoW, H: videoH}
+		s.videoStates[i] = video
+	}
+}
+
 func (s *detailScreenState) createSlideshowState(section Section) slideshowState {
 	maxWidth := section.MaxWidth
 	maxHeight := section.MaxHeight
@@ -239,12 +352,14 @@ func (s *detailScreenState) createSlideshowState(section Section) slideshowState
 	}
 
 	var textures []*sdl.Texture
+	var animations []*animatedImage
 	var dimensions []sdl.Rect
 
 	for _, imagePath := range imagesToLoad {
-		texture, rect := s.loadAndScaleImage(imagePath, maxWidth, maxHeight, section)
+		texture, animation, rect := s.loadAndScaleImage(imagePath, maxWidth, maxHeight, section)
 		if texture != nil {
 			textures = append(textures, texture)
+			animations = append(animations, animation)
 			dimensions = append(dimensions, rect)
 		}
 	}
@@ -252,25 +367,37 @@ func (s *detailScreenState) createSlideshowState(section Section) slideshowState
 	return slideshowState{
 		currentIndex: 0,
 		textures:     textures,
+		animations:   animations,
 		dimensions:   dimensions,
 	}
 }
 
-func (s *detailScreenState) loadAndScaleImage(imagePath string, maxWidth, maxHeight int32, section Section) (*sdl.Texture, sdl.Rect) {
+func (s *detailScreenState) loadAndScaleImage(imagePath string, maxWidth, maxHeight int32, section Section) (*sdl.Texture, *animatedImage, sdl.Rect) {
+	if strings.HasSuffix(strings.ToLower(imagePath), ".gif") {
+		if data, err := os.ReadFile(imagePath); err == nil && isGIF(data) {
+			if animation, err := loadAnimatedGIF(s.renderer, data); err == nil {
+				imageW, imageH := s.calculateScaledDimensions(animation.width, animation.height, maxWidth, maxHeight)
+				imageX := s.calculateImageX(imageW, section)
+				return animation.texture(), animation, sdl.Rect{X: imageX, Y: 0, W: imageW, H: imageH}
+			}
+		}
+		// Fall through to SDL_image, which loads the first frame
+	}
+
 	image, err := img.Load(imagePath)
 	if err != nil || image == nil {
-		return nil, sdl.Rect{}
+		return nil, nil, sdl.Rect{}
 	}
 	defer image.Free()
 
 	imageW, imageH := s.calculateScaledDimensions(image.W, image.H, maxWidth, maxHeight)
 	texture, err := s.renderer.CreateTextureFromSurface(image)
 	if err != nil {
-		return nil, sdl.Rect{}
+		return nil, nil, sdl.Rect{}
 	}
 
 	imageX := s.calculateImageX(imageW, section)
-	return texture, sdl.Rect{X: imageX, Y: 0, W: imageW, H: imageH}
+	return texture, nil, sdl.Rect{X: imageX, Y: 0, W: imageW, H: imageH}
 }
 
 func (s *detailScreenState) calculateScaledDimensions(originalW, originalH, maxW, maxH int32) (int32, int32) {
@@ -313,12 +440,12 @@ func (s *detailScreenState) isFinished() bool {
 func (s *detailScreenState) handleEvents() {
 	processor := internal.GetInputProcessor()
 
-	if event := sdl.WaitEventTimeout(16); event != nil {
+	if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
 		switch event.(type) {
 		case *sdl.QuitEvent:
 			s.result.Action = DetailActionCancelled
 			return
-		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent:
+		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
 			inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
 			if inputEvent == nil {
 				return
@@ -339,16 +466,46 @@ func (s *detailScreenState) handleInputEvent(inputEvent *internal.Event) {
 	}
 	s.lastInputTime = time.Now()
 
+	if s.showingHelp {
+		s.handleHelpInput(inputEvent.Button)
+		return
+	}
+
+	if s.helpOverlay != nil && inputEvent.Button == s.options.HelpButton {
+		s.showingHelp = true
+		s.helpOverlay.ShowingHelp = true
+		return
+	}
+
+	if footerButtonDisabled(s.footerHelpItems, inputEvent.Button) {
+		return
+	}
+
+	if s.options.ShowTableOfContents && (inputEvent.Button == constants.VirtualButtonL1 || inputEvent.Button == constants.VirtualButtonR1) {
+		s.jumpSection(inputEvent.Button == constants.VirtualButtonL1)
+		return
+	}
+
 	switch inputEvent.Button {
 	case constants.VirtualButtonUp:
 		s.startScrolling(true)
 	case constants.VirtualButtonDown:
 		s.startScrolling(false)
 	case constants.VirtualButtonLeft, constants.VirtualButtonRight:
-		s.handleSlideshowNavigation(inputEvent.Button == constants.VirtualButtonLeft)
+		if s.hasNavigableSlideshow() {
+			s.handleSlideshowNavigation(inputEvent.Button == constants.VirtualButtonLeft)
+		} else if s.options.ShowTableOfContents {
+			s.jumpSection(inputEvent.Button == constants.VirtualButtonLeft)
+		} else {
+			s.cycleSectionFocus(inputEvent.Button == constants.VirtualButtonLeft)
+		}
 	case constants.VirtualButtonB:
 		s.result.Action = DetailActionCancelled
 	case constants.VirtualButtonA, constants.VirtualButtonStart:
+		if inputEvent.Button == constants.VirtualButtonA && s.focusedSection >= 0 {
+			s.activateSection(s.focusedSection)
+			return
+		}
 		s.result.Action = DetailActionConfirmed
 	case s.options.ActionButton:
 		if s.options.EnableAction {
@@ -357,6 +514,65 @@ func (s *detailScreenState) handleInputEvent(inputEvent *internal.Event) {
 	}
 }
 
+func (s *detailScreenState) handleHelpInput(button constants.VirtualButton) {
+	switch button {
+	case constants.VirtualButtonUp:
+		s.helpOverlay.scroll(-1)
+	case constants.VirtualButtonDown:
+		s.helpOverlay.scroll(1)
+	default:
+		s.showingHelp = false
+		s.helpOverlay.ShowingHelp = false
+	}
+}
+
+// defaultHelpLines describes the controls that apply to this screen's sections and options.
+func (s *detailScreenState) defaultHelpLines() []string {
+	lines := []string{"• Up / Down: Scroll"}
+
+	for _, section := range s.options.Sections {
+		if section.Type == SectionTypeSlideshow && len(section.ImagePaths) > 1 {
+			lines = append(lines, "• Left / Right: Previous / next image")
+			break
+		}
+	}
+
+	if s.options.ShowTableOfContents && len(s.options.Sections) > 1 {
+		lines = append(lines, "• L1 / R1: Previous / next section")
+	}
+
+	for i := range s.options.Sections {
+		if s.isSectionFocusable(i) {
+			lines = append(lines, "• Left / Right: Select section")
+			break
+		}
+	}
+
+	for _, section := range s.options.Sections {
+		if section.Collapsible {
+			lines = append(lines, "• A: Collapse / expand section")
+			break
+		}
+	}
+
+	for i, section := range s.options.Sections {
+		if (section.Type == SectionTypeImage || section.Type == SectionTypeSlideshow) && s.isSectionFocusable(i) {
+			lines = append(lines, "• A: View image full screen")
+			break
+		}
+	}
+
+	lines = append(lines, "• A / Start: Confirm")
+	actionButton := s.options.ActionButton
+	if s.options.EnableAction && actionButton != constants.VirtualButtonUnassigned &&
+		actionButton != constants.VirtualButtonA && actionButton != constants.VirtualButtonStart {
+		lines = append(lines, "• "+actionButton.GetName()+": Action")
+	}
+	lines = append(lines, "• B: Back")
+
+	return lines
+}
+
 func (s *detailScreenState) handleInputEventRelease(inputEvent *internal.Event) {
 	switch inputEvent.Button {
 	case constants.VirtualButtonUp:
@@ -371,6 +587,7 @@ func (s *detailScreenState) isInputAllowed() bool {
 }
 
 func (s *detailScreenState) startScrolling(up bool) {
+	s.sectionJumped = false
 	if up {
 		s.heldDirections.up = true
 		s.heldDirections.down = false
@@ -402,9 +619,113 @@ func (s *detailScreenState) findActiveSlideshow() int {
 	return s.activeSlideshow
 }
 
+func (s *detailScreenState) hasNavigableSlideshow() bool {
+	state, ok := s.slideshowStates[s.findActiveSlideshow()]
+	return ok && len(state.textures) > 1
+}
+
+// isSectionFocusable reports whether left/right can focus a section: collapsible sections and loaded images.
+func (s *detailScreenState) isSectionFocusable(index int) bool {
+	section := s.options.Sections[index]
+	if section.Collapsible {
+		return true
+	}
+
+	if section.Type == SectionTypeImage || section.Type == SectionTypeSlideshow {
+		state, ok := s.slideshowStates[index]
+		return ok && len(state.textures) > 0
+	}
+	return false
+}
+
+// activateSection handles A on a focused section. Expanded image sections open full screen; otherwise the section
+// collapses or expands.
+func (s *detailScreenState) activateSection(index int) {
+	section := s.options.Sections[index]
+	if (section.Type == SectionTypeImage || section.Type == SectionTypeSlideshow) && !s.collapsedSections[index] {
+		if _, ok := s.slideshowStates[index]; ok {
+			s.showZoomedImage(index)
+			return
+		}
+	}
+
+	s.toggleSection(index)
+}
+
+// cycleSectionFocus moves focus to the previous or next focusable section and scrolls its title into view.
+func (s *detailScreenState) cycleSectionFocus(backwards bool) {
+	count := len(s.options.Sections)
+	index := s.focusedSection
+
+	for range count {
+		if backwards {
+			index = (index - 1 + count) % count
+		} else {
+			index = (index + 1) % count
+		}
+
+		if s.isSectionFocusable(index) {
+			s.focusedSection = index
+			s.scrollToSection(index)
+			return
+		}
+	}
+}
+
+func (s *detailScreenState) scrollToSection(index int) {
+	if index < 0 || index >= len(s.sectionOffsets) {
+		return
+	}
+
+	margins := internal.UniformPadding(20).WithSafeArea()
+	contentTop := margins.Top + s.tableOfContentsHeight()
+	s.targetScrollY = internal.Min32(s.maxScrollY, internal.Max32(0, s.sectionOffsets[index]-contentTop))
+}
+
+// jumpSection scrolls straight to the previous or next section, focusing it when it can be focused.
+func (s *detailScreenState) jumpSection(backwards bool) {
+	if len(s.options.Sections) == 0 {
+		return
+	}
+
+	if backwards && s.currentSection > 0 {
+		s.currentSection--
+	} else if !backwards && s.currentSection < len(s.options.Sections)-1 {
+		s.currentSection++
+	}
+
+	s.sectionJumped = true
+	s.scrollToSection(s.currentSection)
+
+	if s.isSectionFocusable(s.currentSection) {
+		s.focusedSection = s.currentSection
+	} else {
+		s.focusedSection = -1
+	}
+}
+
+func (s *detailScreenState) toggleSection(index int) {
+	if index < 0 || index >= len(s.options.Sections) || !s.options.Sections[index].Collapsible {
+		return
+	}
+
+	if s.collapsedSections[index] {
+		delete(s.collapsedSections, index)
+	} else {
+		s.collapsedSections[index] = true
+	}
+}
+
 func (s *detailScreenState) update() {
 	s.handleDirectionalRepeats()
-	s.scrollY += int32(float32(s.targetScrollY-s.scrollY) * s.scrollAnimationSpeed)
+	for _, video := range s.videoStates {
+		video.update()
+	}
+	if internal.ReducedMotion() {
+		s.scrollY = s.targetScrollY
+	} else {
+		s.scrollY += int32(float32(s.targetScrollY-s.scrollY) * s.scrollAnimationSpeed)
+	}
 }
 
 func (s *detailScreenState) handleDirectionalRepeats() {
@@ -438,16 +759,29 @@ func (s *detailScreenState) handleDirectionalRepeats() {
 }
 
 func (s *detailScreenState) render() {
+	defer internal.OverrideTheme(s.options.ThemeOverride)()
+
 	s.clearScreen()
 
-	margins := internal.UniformPadding(20)
-	footerHeight := int32(30)
+	margins := internal.UniformPadding(20).WithSafeArea()
+	footerHeight := int32(float32(30) * internal.GetFontScale())
 	safeAreaHeight := s.window.GetHeight() - footerHeight
 
 	statusBarWidth := calculateStatusBarWidth(internal.Fonts.SmallFont, s.options.StatusBar)
 
-	currentY := s.renderTitle(margins, statusBarWidth)
-	currentY, totalContentHeight := s.renderSections(margins, currentY, safeAreaHeight)
+	// Content starts below the table of contents, which stays pinned while the rest scrolls
+	contentMargins := margins
+	contentMargins.Top += s.tableOfContentsHeight()
+
+	currentY := s.renderTitle(contentMargins, statusBarWidth)
+	currentY, totalContentHeight := s.renderSections(contentMargins, currentY, safeAreaHeight)
+
+	if s.options.ShowTableOfContents {
+		if !s.sectionJumped {
+			s.updateCurrentSection(contentMargins.Top)
+		}
+		s.renderTableOfContents(margins, statusBarWidth)
+	}
 
 	renderStatusBar(s.renderer, internal.Fonts.SmallFont, s.options.StatusBar, margins)
 
@@ -455,7 +789,107 @@ func (s *detailScreenState) render() {
 	s.renderScrollbar(safeAreaHeight)
 	s.renderFooter(margins)
 
-	s.renderer.Present()
+	if s.showingHelp && s.helpOverlay != nil {
+		s.helpOverlay.render(s.renderer, internal.Fonts.SmallFont)
+	}
+
+	internal.DebugOverlay.Render(s.renderer, internal.DebugInfo{SelectedIndex: -1, TextLength: -1})
+	s.window.Present()
+}
+
+func (s *detailScreenState) tableOfContentsHeight() int32 {
+	if !s.options.ShowTableOfContents || len(s.options.Sections) == 0 {
+		return 0
+	}
+	return s.tableOfContentsPillHeight() + 10
+}
+
+func (s *detailScreenState) tableOfContentsPillHeight() int32 {
+	return int32(internal.Fonts.TinyFont.Height()) + int32(float32(12)*internal.GetScaleFactor())
+}
+
+// updateCurrentSection marks the last section whose title has scrolled to the top of the content area as current.
+func (s *detailScreenState) updateCurrentSection(contentTop int32) {
+	s.currentSection = 0
+	for i, offset := range s.sectionOffsets {
+		if offset-contentTop <= s.targetScrollY {
+			s.currentSection = i
+		}
+	}
+}
+
+// renderTableOfContents draws a pill per section along the top of the screen, highlighting the current one.
+// The strip shifts left when needed so the current pill stays in view.
+func (s *detailScreenState) renderTableOfContents(margins internal.Padding, statusBarWidth int32) {
+	if len(s.options.Sections) == 0 {
+		return
+	}
+
+	font := internal.Fonts.TinyFont
+	theme := internal.GetTheme()
+	scaleFactor := internal.GetScaleFactor()
+	pillHeight := s.tableOfContentsPillHeight()
+	pillPadding := int32(float32(12) * scaleFactor)
+	pillGap := int32(float32(8) * scaleFactor)
+	maxLabelWidth := int32(float32(150) * scaleFactor * internal.GetFontScale())
+	stripWidth := s.window.GetWidth() - margins.Left - margins.Right - statusBarWidth
+
+	// Cover content that has scrolled up underneath the strip
+	s.renderer.SetDrawColor(s.options.BackgroundColor.R, s.options.BackgroundColor.G, s.options.BackgroundColor.B, 255)
+	s.renderer.FillRect(&sdl.Rect{X: 0, Y: 0, W: s.window.GetWidth(), H: margins.Top + s.tableOfContentsHeight()})
+
+	labels := make([]string, len(s.options.Sections))
+	widths := make([]int32, len(s.options.Sections))
+	for i, section := range s.options.Sections {
+		labels[i] = section.Title
+		if labels[i] == "" {
+			labels[i] = fmt.Sprintf("%d", i+1)
+		}
+		labels[i] = truncateFilename(labels[i], maxLabelWidth, font)
+
+		textW, _, _ := font.SizeUTF8(labels[i])
+		widths[i] = int32(textW) + pillPadding*2
+	}
+
+	offset := int32(0)
+	currentEnd := int32(0)
+	for i := 0; i <= s.currentSection && i < len(widths); i++ {
+		currentEnd += widths[i] + pillGap
+	}
+	if currentEnd-pillGap > stripWidth {
+		offset = currentEnd - pillGap - stripWidth
+	}
+
+	x := margins.Left - offset
+	for i, label := range labels {
+		pillRect := sdl.Rect{X: x, Y: margins.Top, W: widths[i], H: pillHeight}
+		x += widths[i] + pillGap
+
+		if pillRect.X+pillRect.W < margins.Left || pillRect.X > margins.Left+stripWidth {
+			continue
+		}
+
+		pillColor := sdl.Color{R: 50, G: 50, B: 50, A: 255}
+		textColor := theme.TextColor
+		if i == s.currentSection {
+			pillColor = theme.HighlightColor
+			textColor = theme.HighlightedTextColor
+		}
+		internal.DrawRoundedRect(s.renderer, &pillRect, pillHeight/2, pillColor)
+
+		cacheKey := fmt.Sprintf("toc_%s_%d_%d_%d", label, textColor.R, textColor.G, textColor.B)
+		texture := s.textureCache.Get(cacheKey)
+		if texture == nil {
+			texture = renderText(s.renderer, label, font, textColor)
+			if texture == nil {
+				continue
+			}
+			s.textureCache.Set(cacheKey, texture)
+		}
+
+		_, _, textW, textH, _ := texture.Query()
+		s.renderer.Copy(texture, nil, &sdl.Rect{X: pillRect.X + (pillRect.W-textW)/2, Y: pillRect.Y + (pillHeight-textH)/2, W: textW, H: textH})
+	}
 }
 
 func (s *detailScreenState) clearScreen() {
@@ -487,8 +921,15 @@ func (s *detailScreenState) renderTitle(margins internal.Padding, statusBarWidth
 		displayWidth = maxTitleWidth
 	}
 
-	// Center the title horizontally
-	titleX := (s.window.GetWidth() - displayWidth) / 2
+	var titleX int32
+	switch s.options.TitleAlign {
+	case constants.TextAlignLeft:
+		titleX = margins.Left
+	case constants.TextAlignCenter:
+		titleX = (s.window.GetWidth() - displayWidth) / 2
+	case constants.TextAlignRight:
+		titleX = s.window.GetWidth() - margins.Right - statusBarWidth - displayWidth
+	}
 
 	titleRect := sdl.Rect{
 		X: titleX,
@@ -524,7 +965,12 @@ func (s *detailScreenState) renderSections(margins internal.Padding, startY int3
 			currentY += 30
 		}
 
+		s.sectionOffsets[sectionIndex] = currentY + s.scrollY
+
 		currentY = s.renderSectionTitle(sectionIndex, margins, currentY, safeAreaHeight)
+		if s.collapsedSections[sectionIndex] {
+			continue
+		}
 		currentY = s.renderSectionDivider(margins, contentWidth, currentY, safeAreaHeight)
 		currentY = s.renderSectionContent(sectionIndex, section, margins, contentWidth, currentY, safeAreaHeight)
 	}
@@ -543,8 +989,36 @@ func (s *detailScreenState) renderSectionTitle(sectionIndex int, margins interna
 		return currentY
 	}
 
+	titleX := margins.Left
+	section := s.options.Sections[sectionIndex]
+
+	indicator := ""
+	if section.Collapsible {
+		indicator = "▼"
+		if s.collapsedSections[sectionIndex] {
+			indicator = "▶"
+		}
+	}
+
+	if isRectVisible(sdl.Rect{X: titleX, Y: currentY, W: titleW, H: titleH}, safeAreaHeight) {
+		if sectionIndex == s.focusedSection {
+			padding := int32(10)
+			indicatorW := 0
+			if indicator != "" {
+				indicatorW, _, _ = internal.Fonts.SmallFont.SizeUTF8(indicator)
+				indicatorW += int(padding)
+			}
+			focusRect := sdl.Rect{X: titleX - padding, Y: currentY - padding/2, W: titleW + int32(indicatorW) + padding*2, H: titleH + padding}
+			internal.DrawRoundedRect(s.renderer, &focusRect, focusRect.H/2, internal.GetTheme().HighlightColor)
+		}
+
+		if indicator != "" {
+			titleX += s.renderSectionIndicator(indicator, titleX, currentY, titleH)
+		}
+	}
+
 	sectionTitleRect := sdl.Rect{
-		X: margins.Left,
+		X: titleX,
 		Y: currentY,
 		W: titleW,
 		H: titleH,
@@ -557,6 +1031,23 @@ func (s *detailScreenState) renderSectionTitle(sectionIndex int, margins interna
 	return currentY + titleH + 15
 }
 
+// renderSectionIndicator draws a collapse indicator vertically centered on a section title and returns the width it used.
+func (s *detailScreenState) renderSectionIndicator(indicator string, x, y, titleHeight int32) int32 {
+	cacheKey := "section_indicator_" + indicator
+	texture := s.textureCache.Get(cacheKey)
+	if texture == nil {
+		texture = renderText(s.renderer, indicator, internal.Fonts.SmallFont, s.options.TitleColor)
+		if texture == nil {
+			return 0
+		}
+		s.textureCache.Set(cacheKey, texture)
+	}
+
+	_, _, w, h, _ := texture.Query()
+	s.renderer.Copy(texture, nil, &sdl.Rect{X: x, Y: y + (titleHeight-h)/2, W: w, H: h})
+	return w + 10
+}
+
 func (s *detailScreenState) renderSectionDivider(margins internal.Padding, contentWidth, currentY int32, safeAreaHeight int32) int32 {
 	if isLineVisible(currentY, safeAreaHeight) {
 		s.renderer.SetDrawColor(80, 80, 80, 255)
@@ -571,10 +1062,14 @@ func (s *detailScreenState) renderSectionContent(sectionIndex int, section Secti
 		return s.renderSlideshow(sectionIndex, currentY, safeAreaHeight)
 	case SectionTypeImage:
 		return s.renderImage(sectionIndex, currentY, safeAreaHeight)
+	case SectionTypeVideo:
+		return s.renderVideo(sectionIndex, currentY, safeAreaHeight)
 	case SectionTypeInfo:
 		return s.renderInfo(sectionIndex, section, margins, contentWidth, currentY, safeAreaHeight)
 	case SectionTypeDescription:
 		return s.renderDescription(section, margins, contentWidth, currentY, safeAreaHeight)
+	case SectionTypeTable:
+		return s.renderTable(section, margins, contentWidth, currentY, safeAreaHeight)
 	}
 	return currentY
 }
@@ -589,7 +1084,7 @@ func (s *detailScreenState) renderSlideshow(sectionIndex int, currentY int32, sa
 	imageRect.Y = currentY
 
 	if isRectVisible(imageRect, safeAreaHeight) {
-		s.renderer.Copy(state.textures[state.currentIndex], nil, &imageRect)
+		s.renderer.Copy(state.currentTexture(state.currentIndex), nil, &imageRect)
 		// Set this as the active slideshow when it's being rendered and visible
 		s.activeSlideshow = sectionIndex
 	}
@@ -603,6 +1098,15 @@ func (s *detailScreenState) renderSlideshow(sectionIndex int, currentY int32, sa
 	return currentY
 }
 
+// currentTexture returns the texture to draw for an image, advancing it first if it is animated.
+func (state slideshowState) currentTexture(index int) *sdl.Texture {
+	if index < len(state.animations) && state.animations[index] != nil {
+		state.animations[index].update()
+		return state.animations[index].texture()
+	}
+	return state.textures[index]
+}
+
 func (s *detailScreenState) renderSlideshowIndicators(state slideshowState, currentY int32) int32 {
 	indicatorSize := int32(10)
 	indicatorSpacing := int32(5)
@@ -642,12 +1146,95 @@ func (s *detailScreenState) renderImage(sectionIndex int, currentY int32, safeAr
 	imageRect.Y = currentY
 
 	if isRectVisible(imageRect, safeAreaHeight) {
-		s.renderer.Copy(state.textures[0], nil, &imageRect)
+		s.renderer.Copy(state.currentTexture(0), nil, &imageRect)
 	}
 
 	return currentY + imageRect.H + 15
 }
 
+func (s *detailScreenState) renderVideo(sectionIndex int, currentY int32, safeAreaHeight int32) int32 {
+	video, ok := s.videoStates[sectionIndex]
+	if !ok || video.texture() == nil {
+		return currentY
+	}
+
+	videoRect := video.dimensions
+	videoRect.Y = currentY
+
+	if isRectVisible(videoRect, safeAreaHeight) {
+		s.renderer.Copy(video.texture(), nil, &videoRect)
+	}
+
+	return currentY + videoRect.H + 15
+}
+
+// showZoomedImage shows the current image of an image or slideshow section scaled to fill the window.
+// Left/right move through a slideshow's images and B returns to the detail screen.
+func (s *detailScreenState) showZoomedImage(sectionIndex int) {
+	state, ok := s.slideshowStates[sectionIndex]
+	if !ok || len(state.textures) == 0 {
+		return
+	}
+
+	processor := internal.GetInputProcessor()
+	defer processor.StartCooldown()
+
+	footerItems := []FooterHelpItem{{ButtonName: "B", HelpText: "Close"}}
+	if len(state.textures) > 1 {
+		footerItems = append(footerItems, FooterHelpItem{ButtonName: "←/→", HelpText: "Browse"})
+	}
+
+	for {
+		if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
+			switch event.(type) {
+			case *sdl.QuitEvent:
+				s.result.Action = DetailActionCancelled
+				return
+			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
+				inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
+				if inputEvent == nil || !inputEvent.Pressed || !s.isInputAllowed() {
+					break
+				}
+				s.lastInputTime = time.Now()
+
+				switch inputEvent.Button {
+				case constants.VirtualButtonB:
+					s.slideshowStates[sectionIndex] = state
+					return
+				case constants.VirtualButtonLeft:
+					state.currentIndex = (state.currentIndex - 1 + len(state.textures)) % len(state.textures)
+				case constants.VirtualButtonRight:
+					state.currentIndex = (state.currentIndex + 1) % len(state.textures)
+				}
+			}
+		}
+
+		s.renderZoomedImage(state, footerItems)
+	}
+}
+
+func (s *detailScreenState) renderZoomedImage(state slideshowState, footerItems []FooterHelpItem) {
+	s.renderer.SetDrawColor(0, 0, 0, 255)
+	s.renderer.Clear()
+
+	texture := state.currentTexture(state.currentIndex)
+	if texture != nil {
+		_, _, textureW, textureH, _ := texture.Query()
+		windowW, windowH := s.window.GetWidth(), s.window.GetHeight()
+
+		// Scale up or down to the largest size that fits, keeping the aspect ratio
+		scale := min(float32(windowW)/float32(textureW), float32(windowH)/float32(textureH))
+		imageW, imageH := int32(float32(textureW)*scale), int32(float32(textureH)*scale)
+
+		s.renderer.Copy(texture, nil, &sdl.Rect{X: (windowW - imageW) / 2, Y: (windowH - imageH) / 2, W: imageW, H: imageH})
+	}
+
+	renderFooter(s.renderer, internal.Fonts.SmallFont, footerItems, defaultFooterMargins(), true, false)
+
+	internal.DebugOverlay.Render(s.renderer, internal.DebugInfo{SelectedIndex: -1, TextLength: -1})
+	s.window.Present()
+}
+
 func (s *detailScreenState) renderInfo(sectionIndex int, section Section, margins internal.Padding, contentWidth, currentY int32, safeAreaHeight int32) int32 {
 	labelTextures, ok := s.metadataLabelTextures[sectionIndex]
 	if !ok {
@@ -729,8 +1316,111 @@ func (s *detailScreenState) renderDescription(section Section, margins internal.
 	return currentY + descHeight + 15
 }
 
+func (s *detailScreenState) renderTable(section Section, margins internal.Padding, contentWidth, currentY int32, safeAreaHeight int32) int32 {
+	if len(section.TableData) == 0 {
+		return currentY
+	}
+
+	font := internal.Fonts.SmallFont
+	cellPadding := int32(10)
+	rowHeight := int32(font.Height()) + cellPadding
+	columnWidths := tableColumnWidths(section, contentWidth)
+
+	for row, cells := range section.TableData {
+		rowRect := sdl.Rect{X: margins.Left, Y: currentY, W: contentWidth, H: rowHeight}
+
+		if isRectVisible(rowRect, safeAreaHeight) {
+			// Shade every other data row; the header row stays unshaded
+			if row > 0 && row%2 == 0 {
+				s.renderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
+				s.renderer.SetDrawColor(255, 255, 255, 20)
+				s.renderer.FillRect(&rowRect)
+			}
+
+			color := s.options.MetadataColor
+			if row == 0 {
+				color = s.options.TitleColor
+			}
+
+			cellX := margins.Left
+			for col, width := range columnWidths {
+				if col < len(cells) && cells[col] != "" {
+					s.renderTableCell(cells[col], font, color, cellX+cellPadding/2, currentY, width-cellPadding, rowHeight)
+				}
+				cellX += width
+			}
+		}
+
+		currentY += rowHeight
+
+		if isLineVisible(currentY, safeAreaHeight) {
+			if row == 0 {
+				s.renderer.SetDrawColor(120, 120, 120, 255)
+			} else {
+				s.renderer.SetDrawColor(80, 80, 80, 255)
+			}
+			s.renderer.DrawLine(margins.Left, currentY, margins.Left+contentWidth, currentY)
+		}
+	}
+
+	return currentY + 15
+}
+
+// renderTableCell draws text vertically centered in a cell, truncated with an ellipsis if it is wider than maxWidth.
+func (s *detailScreenState) renderTableCell(text string, font *ttf.Font, color sdl.Color, x, y, maxWidth, rowHeight int32) {
+	if maxWidth <= 0 {
+		return
+	}
+
+	cacheKey := fmt.Sprintf("table_%s_%d_%d_%d_%d", text, color.R, color.G, color.B, maxWidth)
+	texture := s.textureCache.Get(cacheKey)
+	if texture == nil {
+		texture = renderText(s.renderer, truncateFilename(text, maxWidth, font), font, color)
+		if texture == nil {
+			return
+		}
+		s.textureCache.Set(cacheKey, texture)
+	}
+
+	_, _, w, h, _ := texture.Query()
+	s.renderer.Copy(texture, nil, &sdl.Rect{X: x, Y: y + (rowHeight-h)/2, W: internal.Min32(w, maxWidth), H: h})
+}
+
+// tableColumnWidths uses the section's ColumnWidths where set and splits the remaining width evenly across the other columns.
+func tableColumnWidths(section Section, contentWidth int32) []int32 {
+	columns := 0
+	for _, row := range section.TableData {
+		columns = max(columns, len(row))
+	}
+
+	widths := make([]int32, columns)
+	remaining := contentWidth
+	unset := 0
+	for i := range widths {
+		if i < len(section.ColumnWidths) && section.ColumnWidths[i] > 0 {
+			widths[i] = section.ColumnWidths[i]
+			remaining -= widths[i]
+		} else {
+			unset++
+		}
+	}
+
+	if unset > 0 {
+		share := internal.Max32(remaining/int32(unset), 0)
+		for i := range widths {
+			if widths[i] == 0 {
+				widths[i] = share
+			}
+		}
+	}
+
+	return widths
+}
+
 func (s *detailScreenState) updateScrollLimits(totalContentHeight int32, safeAreaHeight int32, margins internal.Padding) {
 	s.maxScrollY = internal.Max32(0, totalContentHeight-safeAreaHeight+margins.Bottom)
+	// Collapsing a section can shrink the content below the current scroll position
+	s.targetScrollY = internal.Min32(s.targetScrollY, s.maxScrollY)
 }
 
 func (s *detailScreenState) renderScrollbar(safeAreaHeight int32) {
@@ -788,7 +1478,7 @@ func (s *detailScreenState) renderFooter(margins internal.Padding) {
 			s.renderer,
 			internal.Fonts.SmallFont,
 			s.footerHelpItems,
-			margins.Bottom,
+			margins,
 			false,
 			true,
 		)
@@ -817,10 +1507,18 @@ func (s *detailScreenState) cleanup() {
 	}
 
 	for _, state := range s.slideshowStates {
-		for _, texture := range state.textures {
+		for i, texture := range state.textures {
+			if i < len(state.animations) && state.animations[i] != nil {
+				state.animations[i].destroy()
+				continue
+			}
 			texture.Destroy()
 		}
 	}
+
+	for _, video := range s.videoStates {
+		video.destroy()
+	}
 }
 
 func renderText(renderer *sdl.Renderer, text string, font *ttf.Font, color sdl.Color) *sdl.Texture {
@@ -1,11 +1,21 @@
 package gabagool
 
 import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
@@ -16,16 +26,36 @@ import (
 )
 
 type Download struct {
-	URL         string
-	Location    string
+	URL string
+
+	// Location is where the file is saved. It is written to Location+".part" and renamed once complete,
+	// so an interrupted download can resume where it left off.
+	Location string
+
 	DisplayName string
-	Timeout     time.Duration
+
+	// Timeout limits how long the download may take, not counting time spent paused. Defaults to 120 minutes.
+	Timeout time.Duration
+
+	// MaxRetries is how many more times a failed download is attempted. Each retry waits RetryBackoff
+	// (one second if unset), doubling after every attempt.
+	MaxRetries   int
+	RetryBackoff time.Duration
+
+	// MaxBytesPerSecond caps this download's transfer rate. 0 means unlimited.
+	MaxBytesPerSecond int64
+
+	// Checksum is the expected hex digest of the file, checked when DownloadManagerOptions.VerifyChecksum is set.
+	// ChecksumAlgorithm is "sha256" (the default) or "md5".
+	Checksum          string
+	ChecksumAlgorithm string
 }
 
 // DownloadError represents a failed download with its error.
 type DownloadError struct {
-	Download Download
-	Error    error
+	Download         Download
+	Error            error
+	ChecksumMismatch bool // The file downloaded but did not match Download.Checksum
 }
 
 // DownloadResult represents the result of the DownloadManager.
@@ -37,6 +67,40 @@ type DownloadResult struct {
 type DownloadManagerOptions struct {
 	AutoContinue  bool
 	MaxConcurrent int
+
+	// ConfirmCancel asks the user to confirm before Y cancels the active downloads.
+	ConfirmCancel bool
+
+	// OnAllComplete is called once when every download has finished (successfully or not),
+	// before AutoContinue closes the screen. It is not called if the user cancels.
+	OnAllComplete func(DownloadResult)
+
+	// PauseButton pauses and ResumeButton resumes the highlighted download. Up/down move the highlight
+	// when several downloads are shown. Setting both to the same button makes it a toggle.
+	PauseButton  constants.VirtualButton
+	ResumeButton constants.VirtualButton
+
+	// VerifyChecksum checks each finished file against Download.Checksum. A file that doesn't match is deleted and
+	// downloaded again up to ChecksumRetries times before the download fails.
+	VerifyChecksum  bool
+	ChecksumRetries int
+
+	// ShowETA shows the estimated time remaining and the time elapsed below each progress bar.
+	ShowETA bool
+
+	// GlobalMaxBytesPerSecond caps the combined rate of all downloads, on top of each Download.MaxBytesPerSecond.
+	// 0 means unlimited.
+	GlobalMaxBytesPerSecond int64
+
+	// TokenRefresher supplies an OAuth 2.0 access token, sent as "Authorization: Bearer <token>". It is called
+	// before each download, and once more to retry a download the server answers with 401 Unauthorized,
+	// so tokens that expire during a long queue are renewed. The context is cancelled if the download is.
+	TokenRefresher func(ctx context.Context) (string, error)
+
+	// ProxyURL sends downloads through a proxy, such as "http://proxy.example.com:8080" or "socks5://127.0.0.1:1080".
+	// http, https and socks5 proxies are supported. When empty, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables are used.
+	ProxyURL string
 }
 
 type downloadJob struct {
@@ -49,6 +113,12 @@ type downloadJob struct {
 	hasError       bool
 	error          error
 	cancelChan     chan struct{}
+	retries        int
+	attempt        int
+	retrying       bool
+	startTime      time.Time
+	paused         bool
+	pauseChan      chan struct{}
 
 	lastSpeedUpdate time.Time
 	lastSpeedBytes  int64
@@ -78,6 +148,25 @@ type downloadManager struct {
 	inputDelay    time.Duration
 
 	showSpeed bool
+
+	confirmCancel    bool
+	confirmingCancel bool
+
+	pauseButton      constants.VirtualButton
+	resumeButton     constants.VirtualButton
+	selectedJobIndex int
+
+	verifyChecksum  bool
+	checksumRetries int
+
+	showETA bool
+
+	retryChan chan *downloadJob
+
+	globalLimiter *rateLimiter
+
+	tokenRefresher func(ctx context.Context) (string, error)
+	proxyURL       string
 }
 
 func newDownloadManager(downloads []Download, headers map[string]string) *downloadManager {
@@ -108,17 +197,29 @@ func newDownloadManager(downloads []Download, headers map[string]string) *downlo
 		lastInputTime:      time.Now(),
 		inputDelay:         constants.DefaultInputDelay,
 		showSpeed:          false,
+		retryChan:          make(chan *downloadJob, len(downloads)),
 	}
 }
 
 // DownloadManager manages and displays download progress.
 // Returns ErrCancelled if the user cancels the downloads.
 func DownloadManager(downloads []Download, headers map[string]string, opts DownloadManagerOptions) (*DownloadResult, error) {
+	defer internal.GetInputProcessor().StartCooldown()
+
 	downloadManager := newDownloadManager(downloads, headers)
 
 	if opts.MaxConcurrent > 0 {
 		downloadManager.maxConcurrent = opts.MaxConcurrent
 	}
+	downloadManager.confirmCancel = opts.ConfirmCancel
+	downloadManager.pauseButton = opts.PauseButton
+	downloadManager.resumeButton = opts.ResumeButton
+	downloadManager.verifyChecksum = opts.VerifyChecksum
+	downloadManager.checksumRetries = opts.ChecksumRetries
+	downloadManager.showETA = opts.ShowETA
+	downloadManager.globalLimiter = newRateLimiter(opts.GlobalMaxBytesPerSecond)
+	downloadManager.tokenRefresher = opts.TokenRefresher
+	downloadManager.proxyURL = opts.ProxyURL
 
 	result := DownloadResult{
 		Completed: []Download{},
@@ -147,6 +248,7 @@ func DownloadManager(downloads []Download, headers map[string]string, opts Downl
 			isComplete: false,
 			hasError:   false,
 			cancelChan: make(chan struct{}),
+			pauseChan:  make(chan struct{}, 1),
 		}
 		downloadManager.downloadQueue = append(downloadManager.downloadQueue, job)
 	}
@@ -154,13 +256,13 @@ func DownloadManager(downloads []Download, headers map[string]string, opts Downl
 	downloadManager.startNextDownloads()
 
 	downloadManager.render(renderer)
-	renderer.Present()
+	window.Present()
 
 	running := true
 	var err error
 
 	for running {
-		if event := sdl.WaitEventTimeout(16); event != nil {
+		if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
 			switch event.(type) {
 			case *sdl.QuitEvent:
 				running = false
@@ -168,23 +270,39 @@ func DownloadManager(downloads []Download, headers map[string]string, opts Downl
 				downloadManager.cancelAllDownloads()
 				cancelled = true
 
-			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent:
+			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
 				inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
 				if inputEvent != nil && inputEvent.Pressed && downloadManager.isInputAllowed() {
 					downloadManager.lastInputTime = time.Now()
 
 					if downloadManager.isAllComplete {
 						running = false
+					} else if downloadManager.confirmingCancel {
+						switch inputEvent.Button {
+						case constants.VirtualButtonA, constants.VirtualButtonY:
+							downloadManager.confirmingCancel = false
+							downloadManager.cancelAllDownloads()
+							cancelled = true
+						case constants.VirtualButtonB:
+							downloadManager.confirmingCancel = false
+						}
 					} else if inputEvent.Button == constants.VirtualButtonY {
-						downloadManager.cancelAllDownloads()
-						cancelled = true
+						if downloadManager.confirmCancel {
+							downloadManager.confirmingCancel = true
+						} else {
+							downloadManager.cancelAllDownloads()
+							cancelled = true
+						}
 					} else if inputEvent.Button == constants.VirtualButtonX {
 						downloadManager.showSpeed = !downloadManager.showSpeed
+					} else if downloadManager.pauseEnabled() {
+						downloadManager.handlePauseInput(inputEvent.Button)
 					}
 				}
 			}
 		}
 
+		downloadManager.requeueRetries()
 		downloadManager.updateJobStatus()
 
 		if len(downloadManager.activeJobs) < downloadManager.maxConcurrent && len(downloadManager.downloadQueue) > 0 {
@@ -193,6 +311,11 @@ func DownloadManager(downloads []Download, headers map[string]string, opts Downl
 
 		if len(downloadManager.activeJobs) == 0 && len(downloadManager.downloadQueue) == 0 && !downloadManager.isAllComplete {
 			downloadManager.isAllComplete = true
+			downloadManager.confirmingCancel = false
+
+			if opts.OnAllComplete != nil {
+				opts.OnAllComplete(downloadManager.buildResult())
+			}
 
 			if opts.AutoContinue && len(downloadManager.failedDownloads) == 0 {
 				running = false
@@ -200,7 +323,8 @@ func DownloadManager(downloads []Download, headers map[string]string, opts Downl
 		}
 
 		downloadManager.render(renderer)
-		renderer.Present()
+		internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: -1, TextLength: -1})
+		window.Present()
 	}
 
 	if err != nil {
@@ -211,21 +335,107 @@ func DownloadManager(downloads []Download, headers map[string]string, opts Downl
 		return nil, ErrCancelled
 	}
 
-	result.Completed = downloadManager.completedDownloads
+	result = downloadManager.buildResult()
+
+	return &result, nil
+}
+
+// renderCancelPrompt asks the user to confirm cancelling, over the dimmed download progress.
+func (dm *downloadManager) renderCancelPrompt(renderer *sdl.Renderer) {
+	windowWidth := dm.window.GetWidth()
+	windowHeight := dm.window.GetHeight()
+
+	promptText := "Cancel download?"
+	if len(dm.downloads) > 1 {
+		promptText = "Cancel all downloads?"
+	}
+
+	promptSurface, err := internal.Fonts.SmallFont.RenderUTF8Blended(promptText, internal.GetTheme().TextColor)
+	if err == nil && promptSurface != nil {
+		promptTexture, err := renderer.CreateTextureFromSurface(promptSurface)
+		if err == nil {
+			renderer.Copy(promptTexture, nil, &sdl.Rect{
+				X: (windowWidth - promptSurface.W) / 2,
+				Y: (windowHeight - promptSurface.H) / 2,
+				W: promptSurface.W,
+				H: promptSurface.H,
+			})
+			promptTexture.Destroy()
+		}
+		promptSurface.Free()
+	}
+
+	footerHelpItems := []FooterHelpItem{
+		{ButtonName: "B", HelpText: "Keep Downloading"},
+		{ButtonName: "A", HelpText: "Cancel"},
+	}
+
+	renderFooter(renderer, internal.Fonts.SmallFont, footerHelpItems, defaultFooterMargins(), true, false)
+}
+
+func (dm *downloadManager) buildResult() DownloadResult {
+	result := DownloadResult{
+		Completed: dm.completedDownloads,
+		Failed:    make([]DownloadError, len(dm.failedDownloads)),
+	}
 
-	result.Failed = make([]DownloadError, len(downloadManager.failedDownloads))
-	for i, download := range downloadManager.failedDownloads {
+	for i, download := range dm.failedDownloads {
 		var downloadErr error
-		if i < len(downloadManager.errors) {
-			downloadErr = downloadManager.errors[i]
+		if i < len(dm.errors) {
+			downloadErr = dm.errors[i]
 		}
 		result.Failed[i] = DownloadError{
-			Download: download,
-			Error:    downloadErr,
+			Download:         download,
+			Error:            downloadErr,
+			ChecksumMismatch: errors.Is(downloadErr, ErrChecksumMismatch),
 		}
 	}
 
-	return &result, nil
+	return result
+}
+
+func (dm *downloadManager) pauseEnabled() bool {
+	return dm.pauseButton != constants.VirtualButtonUnassigned || dm.resumeButton != constants.VirtualButtonUnassigned
+}
+
+func (dm *downloadManager) handlePauseInput(button constants.VirtualButton) {
+	visibleJobs := min(len(dm.activeJobs), 3)
+	if visibleJobs == 0 {
+		return
+	}
+
+	switch button {
+	case constants.VirtualButtonUp:
+		dm.selectedJobIndex = (dm.selectedJobIndex - 1 + visibleJobs) % visibleJobs
+		return
+	case constants.VirtualButtonDown:
+		dm.selectedJobIndex = (dm.selectedJobIndex + 1) % visibleJobs
+		return
+	}
+
+	job := dm.activeJobs[dm.selectedJobIndex]
+	if job.paused && button == dm.resumeButton {
+		dm.resumeJob(job)
+	} else if !job.paused && button == dm.pauseButton {
+		dm.pauseJob(job)
+	}
+}
+
+func (dm *downloadManager) pauseJob(job *downloadJob) {
+	job.paused = true
+	job.currentSpeed = 0
+}
+
+func (dm *downloadManager) resumeJob(job *downloadJob) {
+	job.paused = false
+	job.lastSpeedUpdate = time.Now()
+	job.lastSpeedBytes = job.downloadedSize
+
+	// Wake the download goroutine if it is waiting; the buffer keeps a signal sent before it starts waiting
+	select {
+	case job.pauseChan <- struct{}{}:
+	default:
+	}
 }
 
 func (dm *downloadManager) isInputAllowed() bool {
@@ -274,6 +484,9 @@ func (dm *downloadManager) updateJobStatus() {
 	for _, job := range dm.activeJobs {
 		if job.isComplete {
 			dm.completedDownloads = append(dm.completedDownloads, job.download)
+		} else if job.hasError && dm.canRetry(job) {
+			dm.scheduleRetry(job)
+			remaining = append(remaining, job)
 		} else if job.hasError {
 			dm.failedDownloads = append(dm.failedDownloads, job.download)
 			dm.errors = append(dm.errors, job.error)
@@ -283,6 +496,77 @@ func (dm *downloadManager) updateJobStatus() {
 	}
 
 	dm.activeJobs = remaining
+
+	if dm.selectedJobIndex >= len(dm.activeJobs) {
+		dm.selectedJobIndex = max(len(dm.activeJobs)-1, 0)
+	}
+}
+
+func (dm *downloadManager) canRetry(job *downloadJob) bool {
+	if errors.Is(job.error, ErrChecksumMismatch) {
+		if job.retries >= dm.checksumRetries {
+			return false
+		}
+	} else if job.attempt >= job.download.MaxRetries {
+		return false
+	}
+
+	select {
+	case <-job.cancelChan:
+		return false
+	default:
+		return true
+	}
+}
+
+// scheduleRetry keeps a failed job on screen while it waits out its backoff, then hands it to requeueRetries.
+// A checksum mismatch is retried straight away and counts against ChecksumRetries rather than MaxRetries.
+func (dm *downloadManager) scheduleRetry(job *downloadJob) {
+	var backoff time.Duration
+	if errors.Is(job.error, ErrChecksumMismatch) {
+		job.retries++
+		job.progress = 0
+		job.downloadedSize = 0
+	} else {
+		backoff = job.download.RetryBackoff
+		if backoff <= 0 {
+			backoff = time.Second
+		}
+		backoff *= 1 << job.attempt
+		job.attempt++
+	}
+
+	job.retrying = true
+	job.hasError = false
+	job.error = nil
+	job.currentSpeed = 0
+
+	go func() {
+		select {
+		case <-time.After(backoff):
+			dm.retryChan <- job
+		case <-job.cancelChan:
+		}
+	}()
+}
+
+// requeueRetries moves jobs whose backoff has finished to the front of the queue.
+func (dm *downloadManager) requeueRetries() {
+	for {
+		select {
+		case job := <-dm.retryChan:
+			for i, active := range dm.activeJobs {
+				if active == job {
+					dm.activeJobs = append(dm.activeJobs[:i], dm.activeJobs[i+1:]...)
+					job.retrying = false
+					dm.downloadQueue = append([]*downloadJob{job}, dm.downloadQueue...)
+					break
+				}
+			}
+		default:
+			return
+		}
+	}
 }
 
 func (dm *downloadManager) cancelAllDownloads() {
@@ -311,6 +595,13 @@ func (dm *downloadManager) cancelAllDownloads() {
 func (dm *downloadManager) downloadFile(job *downloadJob) {
 	url := job.download.URL
 	filePath := job.download.Location
+	partPath := filePath + partialSuffix
+	validatorPath := partPath + validatorSuffix
+
+	// Retries keep counting from the first attempt
+	if job.startTime.IsZero() {
+		job.startTime = time.Now()
+	}
 
 	dir := filepath.Dir(filePath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -319,17 +610,12 @@ func (dm *downloadManager) downloadFile(job *downloadJob) {
 		return
 	}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		job.hasError = true
-		job.error = err
-		return
-	}
-
-	if dm.headers != nil {
-		for k, v := range dm.headers {
-			req.Header.Add(k, v)
-		}
+	// Resume from a partial file left by an earlier, interrupted attempt. Without a validator there is no way
+	// to tell whether the file on the server has changed since, so the download starts over.
+	var existingSize int64
+	validator := readValidator(validatorPath)
+	if info, err := os.Stat(partPath); err == nil && info.Mode().IsRegular() && validator != "" {
+		existingSize = info.Size()
 	}
 
 	// Clone the default transport to preserve certifiable's root CA configuration
@@ -340,41 +626,72 @@ func (dm *downloadManager) downloadFile(job *downloadJob) {
 	transport.IdleConnTimeout = 90 * time.Second
 	transport.MaxIdleConnsPerHost = 10
 
+	proxy, err := downloadProxy(dm.proxyURL)
+	if err != nil {
+		job.hasError = true
+		job.error = err
+		return
+	}
+	transport.Proxy = proxy
+
+	// The timeout is enforced by the deadline rather than the client, which would also count time spent paused
 	client := &http.Client{
-		Timeout:   job.timeout,
 		Transport: transport,
 	}
-	resp, err := client.Do(req)
+	ctx, cancel := jobContext(job)
+	defer cancel()
+	deadline := newDownloadDeadline(job.timeout, cancel)
+	defer deadline.stop()
+
+	resp, err := dm.authorizedRequest(ctx, client, url, existingSize, validator)
+	if err == nil && existingSize > 0 && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		// The partial file doesn't match what the server has, so start over
+		resp.Body.Close()
+		existingSize = 0
+		resp, err = dm.authorizedRequest(ctx, client, url, 0, "")
+	}
 	if err != nil {
 		job.hasError = true
-		job.error = err
+		job.error = deadline.explain(err)
 		return
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	var out *os.File
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		out, err = os.OpenFile(partPath, os.O_WRONLY|os.O_APPEND, 0644)
+	case http.StatusOK:
+		// The server ignored the range request or the file changed, so the whole file is coming again
+		existingSize = 0
+		if err = writeValidator(validatorPath, resumeValidator(resp)); err == nil {
+			out, err = os.Create(partPath)
+		}
+	default:
 		job.hasError = true
 		job.error = fmt.Errorf("bad status: %s", resp.Status)
 		return
 	}
-
-	job.totalSize = resp.ContentLength
-
-	out, err := os.Create(filePath)
 	if err != nil {
 		job.hasError = true
 		job.error = err
 		return
 	}
-	defer out.Close()
+
+	job.totalSize = resp.ContentLength
+	if job.totalSize > 0 {
+		job.totalSize += existingSize
+	}
+	job.downloadedSize = existingSize
 
 	job.lastSpeedUpdate = time.Now()
-	job.lastSpeedBytes = 0
+	job.lastSpeedBytes = existingSize
 	job.currentSpeed = 0
 
 	reader := &progressReader{
-		reader: resp.Body,
+		reader: &pausableReader{reader: resp.Body, job: job, deadline: deadline},
 		onProgress: func(bytesRead int64) {
+			bytesRead += existingSize
 			job.downloadedSize = bytesRead
 			if job.totalSize > 0 {
 				job.progress = float64(bytesRead) / float64(job.totalSize)
@@ -390,28 +707,210 @@ func (dm *downloadManager) downloadFile(job *downloadJob) {
 			}
 		},
 		reportInterval: 1024,
+		limiters:       []*rateLimiter{newRateLimiter(job.download.MaxBytesPerSecond), dm.globalLimiter},
 	}
 
 	done := make(chan error, 1)
 	go func() {
 		_, err := io.Copy(out, reader)
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
 		done <- err
 	}()
 
 	select {
 	case err := <-done:
 		if err != nil {
+			job.hasError = true
+			job.error = deadline.explain(err)
+			return
+		}
+
+		if dm.verifyChecksum && job.download.Checksum != "" {
+			// Delete a corrupt file so the retry scheduled by updateJobStatus starts from scratch
+			if err := verifyChecksum(partPath, job.download.ChecksumAlgorithm, job.download.Checksum); err != nil {
+				os.Remove(partPath)
+				os.Remove(validatorPath)
+				job.hasError = true
+				job.error = err
+				return
+			}
+		}
+
+		if err := os.Rename(partPath, filePath); err != nil {
 			job.hasError = true
 			job.error = err
-		} else {
-			job.isComplete = true
+			return
 		}
+		os.Remove(validatorPath)
+
+		job.isComplete = true
 	case <-job.cancelChan:
 		job.hasError = true
 		job.error = fmt.Errorf("download canceled")
 	}
 }
 
+// verifyChecksum compares the digest of the file at path with the expected hex digest.
+// A mismatch returns an error wrapping ErrChecksumMismatch.
+func verifyChecksum(path, algorithm, expected string) error {
+	var hasher hash.Hash
+	switch strings.ToLower(algorithm) {
+	case "", "sha256":
+		hasher = sha256.New()
+	case "md5":
+		hasher = md5.New()
+	default:
+		return fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(hasher, file); err != nil {
+		return err
+	}
+
+	actual := hex.EncodeToString(hasher.Sum(nil))
+	if !strings.EqualFold(actual, strings.TrimSpace(expected)) {
+		return fmt.Errorf("%w for %s: expected %s, got %s", ErrChecksumMismatch, filepath.Base(path), expected, actual)
+	}
+	return nil
+}
+
+// partialSuffix is appended to Download.Location while a file is downloading. It is renamed into place once complete.
+const partialSuffix = ".part"
+
+// validatorSuffix is appended to the partial file's path to store the ETag or Last-Modified date it was downloaded
+// against, so a resumed download only continues if the file on the server hasn't changed.
+const validatorSuffix = ".validator"
+
+// resumeValidator returns the value to send as If-Range when resuming resp's body: its ETag, or its Last-Modified
+// date if it has no strong ETag. Weak ETags can't be used with If-Range.
+func resumeValidator(resp *http.Response) string {
+	if etag := resp.Header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return resp.Header.Get("Last-Modified")
+}
+
+func readValidator(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// writeValidator stores validator at path, or removes path when there is none to store.
+func writeValidator(path, validator string) error {
+	if validator == "" {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return os.WriteFile(path, []byte(validator), 0644)
+}
+
+// downloadProxy returns the transport Proxy function for rawURL, or the environment's proxy settings if it is empty.
+func downloadProxy(rawURL string) (func(*http.Request) (*url.URL, error), error) {
+	if rawURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxyURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+		return http.ProxyURL(proxyURL), nil
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme: %q", proxyURL.Scheme)
+	}
+}
+
+// jobContext returns a context that is cancelled when the job is.
+func jobContext(job *downloadJob) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-job.cancelChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// authorizedRequest is requestDownload with a bearer token from TokenRefresher, when one is set. A 401 response
+// gets a fresh token and one more try, since the token may have expired.
+func (dm *downloadManager) authorizedRequest(ctx context.Context, client *http.Client, url string, offset int64, validator string) (*http.Response, error) {
+	token, err := dm.bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := dm.requestDownload(ctx, client, url, offset, validator, token)
+	if err != nil || dm.tokenRefresher == nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	if token, err = dm.bearerToken(ctx); err != nil {
+		return nil, err
+	}
+	return dm.requestDownload(ctx, client, url, offset, validator, token)
+}
+
+func (dm *downloadManager) bearerToken(ctx context.Context) (string, error) {
+	if dm.tokenRefresher == nil {
+		return "", nil
+	}
+
+	token, err := dm.tokenRefresher(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh token: %w", err)
+	}
+	return token, nil
+}
+
+// requestDownload starts the GET request for a download. A non-zero offset asks the server for only the bytes from
+// offset onwards, which it signals by answering 206 Partial Content. The range is sent with validator as If-Range, so a
+// server whose file has changed answers 200 OK with the whole new file instead. A token is sent as a bearer
+// Authorization header. Cancelling ctx aborts the request.
+func (dm *downloadManager) requestDownload(ctx context.Context, client *http.Client, url string, offset int64, validator, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if dm.headers != nil {
+		for k, v := range dm.headers {
+			req.Header.Add(k, v)
+		}
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if validator != "" {
+			req.Header.Set("If-Range", validator)
+		}
+	}
+
+	return client.Do(req)
+}
+
 func truncateFilename(filename string, maxWidth int32, font *ttf.Font) string {
 	surface, _ := font.RenderUTF8Blended(filename, sdl.Color{R: 255, G: 255, B: 255, A: 255})
 	if surface == nil {
@@ -441,6 +940,17 @@ func truncateFilename(filename string, maxWidth int32, font *ttf.Font) string {
 }
 
 func (dm *downloadManager) render(renderer *sdl.Renderer) {
+	if dm.confirmingCancel {
+		internal.ShowModal(renderer, func() { dm.renderProgress(renderer) }, func() { dm.renderCancelPrompt(renderer) })
+		return
+	}
+
+	dm.renderProgress(renderer)
+	dm.renderFooter(renderer)
+}
+
+// renderProgress draws the downloads and their progress, everything but the footer.
+func (dm *downloadManager) renderProgress(renderer *sdl.Renderer) {
 	renderer.SetDrawColor(0, 0, 0, 255)
 	renderer.Clear()
 
@@ -510,6 +1020,10 @@ func (dm *downloadManager) render(renderer *sdl.Renderer) {
 			singleDownloadHeight += speedTextHeight + 5
 		}
 
+		if dm.showETA {
+			singleDownloadHeight += filenameHeight + 5
+		}
+
 		averageSpeedHeight := int32(0)
 		if dm.showSpeed && len(dm.activeJobs) > 1 {
 			avgSpeed := dm.getAverageSpeed()
@@ -552,7 +1066,7 @@ func (dm *downloadManager) render(renderer *sdl.Renderer) {
 
 				for i, job := range dm.activeJobs {
 					itemY := startY + int32(i)*(singleDownloadHeight+spacingBetweenDownloads)
-					dm.renderDownloadItem(renderer, job, windowWidth, itemY, filenameHeight, spacingBetweenFilenameAndBar)
+					dm.renderDownloadItem(renderer, job, i == dm.selectedJobIndex, windowWidth, itemY, filenameHeight, spacingBetweenFilenameAndBar)
 				}
 			} else {
 				dm.renderMultipleDownloads(renderer, windowWidth, contentAreaStart+averageSpeedHeight, contentAreaHeight-averageSpeedHeight, filenameHeight, spacingBetweenFilenameAndBar, spacingBetweenDownloads, singleDownloadHeight)
@@ -560,6 +1074,9 @@ func (dm *downloadManager) render(renderer *sdl.Renderer) {
 		}
 	}
 
+}
+
+func (dm *downloadManager) renderFooter(renderer *sdl.Renderer) {
 	var footerHelpItems []FooterHelpItem
 	if dm.isAllComplete {
 		footerHelpItems = append(footerHelpItems, FooterHelpItem{ButtonName: "A", HelpText: "Close"})
@@ -575,9 +1092,39 @@ func (dm *downloadManager) render(renderer *sdl.Renderer) {
 			speedToggleText = "Hide Speed"
 		}
 		footerHelpItems = append(footerHelpItems, FooterHelpItem{ButtonName: "X", HelpText: speedToggleText})
+
+		if dm.selectedJobIndex < len(dm.activeJobs) {
+			if dm.activeJobs[dm.selectedJobIndex].paused {
+				if dm.resumeButton != constants.VirtualButtonUnassigned {
+					footerHelpItems = append(footerHelpItems, FooterHelpItem{ButtonName: dm.resumeButton.GetName(), HelpText: "Resume"})
+				}
+			} else if dm.pauseButton != constants.VirtualButtonUnassigned {
+				footerHelpItems = append(footerHelpItems, FooterHelpItem{ButtonName: dm.pauseButton.GetName(), HelpText: "Pause"})
+			}
+		}
 	}
 
-	renderFooter(renderer, internal.Fonts.SmallFont, footerHelpItems, 20, true, true)
+	renderFooter(renderer, internal.Fonts.SmallFont, footerHelpItems, defaultFooterMargins(), true, true)
+}
+
+func (dm *downloadManager) renderPausedBadge(renderer *sdl.Renderer, x, y, height int32) {
+	font := internal.Fonts.TinyFont
+	badgeSurface, err := font.RenderUTF8Blended("Paused", internal.GetTheme().HighlightedTextColor)
+	if err != nil || badgeSurface == nil {
+		return
+	}
+	defer badgeSurface.Free()
+
+	badgeTexture, err := renderer.CreateTextureFromSurface(badgeSurface)
+	if err != nil {
+		return
+	}
+	defer badgeTexture.Destroy()
+
+	padding := int32(8)
+	badgeRect := sdl.Rect{X: x, Y: y + (height-badgeSurface.H-4)/2, W: badgeSurface.W + padding*2, H: badgeSurface.H + 4}
+	internal.DrawRoundedRect(renderer, &badgeRect, badgeRect.H/2, internal.GetTheme().AccentColor)
+	renderer.Copy(badgeTexture, nil, &sdl.Rect{X: x + padding, Y: badgeRect.Y + 2, W: badgeSurface.W, H: badgeSurface.H})
 }
 
 func (dm *downloadManager) renderMultipleDownloads(renderer *sdl.Renderer, windowWidth int32, contentAreaStart int32, contentAreaHeight int32, filenameHeight int32, spacingBetweenFilenameAndBar int32, spacingBetweenDownloads int32, singleDownloadHeight int32) {
@@ -610,7 +1157,7 @@ func (dm *downloadManager) renderMultipleDownloads(renderer *sdl.Renderer, windo
 		}
 
 		itemY := startY + int32(renderCount)*(singleDownloadHeight+spacingBetweenDownloads)
-		dm.renderDownloadItem(renderer, job, windowWidth, itemY, filenameHeight, spacingBetweenFilenameAndBar)
+		dm.renderDownloadItem(renderer, job, renderCount == dm.selectedJobIndex, windowWidth, itemY, filenameHeight, spacingBetweenFilenameAndBar)
 		renderCount++
 	}
 
@@ -641,7 +1188,7 @@ func (dm *downloadManager) renderMultipleDownloads(renderer *sdl.Renderer, windo
 	}
 }
 
-func (dm *downloadManager) renderDownloadItem(renderer *sdl.Renderer, job *downloadJob, windowWidth int32, startY int32, filenameHeight int32, spacingBetweenFilenameAndBar int32) {
+func (dm *downloadManager) renderDownloadItem(renderer *sdl.Renderer, job *downloadJob, selected bool, windowWidth int32, startY int32, filenameHeight int32, spacingBetweenFilenameAndBar int32) {
 	font := internal.Fonts.SmallFont
 
 	var displayText string
@@ -669,6 +1216,10 @@ func (dm *downloadManager) renderDownloadItem(renderer *sdl.Renderer, job *downl
 			}
 			renderer.Copy(filenameTexture, nil, filenameRect)
 			filenameTexture.Destroy()
+
+			if job.paused {
+				dm.renderPausedBadge(renderer, filenameRect.X+filenameRect.W+10, startY, filenameHeight)
+			}
 		}
 		filenameSurface.Free()
 	}
@@ -682,19 +1233,49 @@ func (dm *downloadManager) renderDownloadItem(renderer *sdl.Renderer, job *downl
 		H: dm.progressBarHeight,
 	}
 
+	// Outline the highlighted download when there are several to choose between
+	if selected && dm.pauseEnabled() && len(dm.activeJobs) > 1 {
+		outline := sdl.Rect{X: progressBarBg.X - 3, Y: progressBarBg.Y - 3, W: progressBarBg.W + 6, H: progressBarBg.H + 6}
+		internal.DrawRoundedRect(renderer, &outline, outline.H/2, internal.GetTheme().HighlightColor)
+	}
+
 	progressWidth := int32(float64(dm.progressBarWidth) * job.progress)
 
+	fillColor := sdl.Color{R: 100, G: 150, B: 255, A: 255}
+	if job.paused {
+		fillColor = sdl.Color{R: 120, G: 120, B: 120, A: 255}
+	}
+
 	// Use smooth progress bar with anti-aliased rounded edges
 	internal.DrawSmoothProgressBar(
 		renderer,
 		&progressBarBg,
 		progressWidth,
 		sdl.Color{R: 50, G: 50, B: 50, A: 255},
-		sdl.Color{R: 100, G: 150, B: 255, A: 255},
+		fillColor,
 	)
 
+	if job.paused {
+		pauseSurface, err := font.RenderUTF8Blended("⏸", sdl.Color{R: 255, G: 255, B: 255, A: 255})
+		if err == nil && pauseSurface != nil {
+			pauseTexture, err := renderer.CreateTextureFromSurface(pauseSurface)
+			if err == nil {
+				renderer.Copy(pauseTexture, nil, &sdl.Rect{
+					X: dm.progressBarX + dm.progressBarHeight/2,
+					Y: progressBarY + (dm.progressBarHeight-pauseSurface.H)/2,
+					W: pauseSurface.W,
+					H: pauseSurface.H,
+				})
+				pauseTexture.Destroy()
+			}
+			pauseSurface.Free()
+		}
+	}
+
 	percentText := fmt.Sprintf("%.0f%%", job.progress*100)
-	if job.totalSize > 0 {
+	if job.retrying {
+		percentText = fmt.Sprintf("Retrying (%d/%d)...", job.attempt, job.download.MaxRetries)
+	} else if job.totalSize > 0 {
 		downloadedMB := float64(job.downloadedSize) / 1048576.0
 		totalMB := float64(job.totalSize) / 1048576.0
 		percentText = fmt.Sprintf("%.0f%% (%.1fMB/%.1fMB)", job.progress*100, downloadedMB, totalMB)
@@ -742,6 +1323,162 @@ func (dm *downloadManager) renderDownloadItem(renderer *sdl.Renderer, job *downl
 			speedSurface.Free()
 		}
 	}
+
+	if dm.showETA {
+		etaY := progressBarY + dm.progressBarHeight + 5
+		// Keep the line in the same place whether or not a speed has been measured yet
+		if dm.showSpeed && len(dm.activeJobs) == 1 {
+			etaY += filenameHeight + 5
+		}
+
+		elapsed := time.Duration(0)
+		if !job.startTime.IsZero() {
+			elapsed = time.Since(job.startTime)
+		}
+
+		etaText := fmt.Sprintf("ETA: %s   Elapsed: %s", formatETA(calculateETA(job)), formatElapsed(elapsed))
+		etaSurface, err := font.RenderUTF8Blended(etaText, sdl.Color{R: 150, G: 150, B: 150, A: 255})
+		if err == nil && etaSurface != nil {
+			etaTexture, err := renderer.CreateTextureFromSurface(etaSurface)
+			if err == nil {
+				renderer.Copy(etaTexture, nil, &sdl.Rect{
+					X: dm.progressBarX + (dm.progressBarWidth-etaSurface.W)/2,
+					Y: etaY,
+					W: etaSurface.W,
+					H: etaSurface.H,
+				})
+				etaTexture.Destroy()
+			}
+			etaSurface.Free()
+		}
+	}
+}
+
+// calculateETA estimates the time left from the current speed. It returns a negative duration when the total size
+// is unknown or nothing is being transferred, such as while the job is paused.
+func calculateETA(job *downloadJob) time.Duration {
+	if job.totalSize <= 0 || job.currentSpeed <= 0 {
+		return -1
+	}
+
+	remaining := float64(job.totalSize-job.downloadedSize) / job.currentSpeed
+	return time.Duration(remaining * float64(time.Second))
+}
+
+// formatETA formats a duration as "1h 5m", "1m 23s" or "42s", or "--:--" when it is negative.
+func formatETA(eta time.Duration) string {
+	if eta < 0 {
+		return "--:--"
+	}
+
+	eta = eta.Round(time.Second)
+	hours := int(eta.Hours())
+	minutes := int(eta.Minutes()) % 60
+	seconds := int(eta.Seconds()) % 60
+
+	switch {
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case minutes > 0:
+		return fmt.Sprintf("%dm %ds", minutes, seconds)
+	default:
+		return fmt.Sprintf("%ds", seconds)
+	}
+}
+
+// formatElapsed formats a duration as "00:45", or "1:02:45" once it passes an hour.
+func formatElapsed(elapsed time.Duration) string {
+	elapsed = elapsed.Round(time.Second)
+	hours := int(elapsed.Hours())
+	minutes := int(elapsed.Minutes()) % 60
+	seconds := int(elapsed.Seconds()) % 60
+
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%02d:%02d", minutes, seconds)
+}
+
+// pausableReader blocks reads while its job is paused, which stalls the transfer until the job is resumed or cancelled.
+// The job's deadline is suspended while it waits.
+type pausableReader struct {
+	reader   io.Reader
+	job      *downloadJob
+	deadline *downloadDeadline
+}
+
+func (r *pausableReader) Read(p []byte) (n int, err error) {
+	if r.job.paused {
+		r.deadline.pause()
+		defer r.deadline.resume()
+	}
+
+	for r.job.paused {
+		select {
+		case <-r.job.pauseChan:
+		case <-r.job.cancelChan:
+			return 0, fmt.Errorf("download canceled")
+		}
+	}
+
+	return r.reader.Read(p)
+}
+
+// downloadDeadline cancels a download that runs longer than its timeout, not counting time spent paused.
+// A nil deadline never expires.
+type downloadDeadline struct {
+	timeout   time.Duration
+	remaining time.Duration
+	started   time.Time
+	timer     *time.Timer
+	paused    bool
+	expired   atomic.Bool
+}
+
+// newDownloadDeadline calls cancel once timeout has passed. It returns nil when timeout is 0 or less.
+func newDownloadDeadline(timeout time.Duration, cancel func()) *downloadDeadline {
+	if timeout <= 0 {
+		return nil
+	}
+
+	d := &downloadDeadline{timeout: timeout, remaining: timeout, started: time.Now()}
+	d.timer = time.AfterFunc(timeout, func() {
+		d.expired.Store(true)
+		cancel()
+	})
+	return d
+}
+
+// pause stops the clock until resume is called.
+func (d *downloadDeadline) pause() {
+	if d == nil || d.paused || !d.timer.Stop() {
+		return
+	}
+	d.remaining -= time.Since(d.started)
+	d.paused = true
+}
+
+func (d *downloadDeadline) resume() {
+	if d == nil || !d.paused {
+		return
+	}
+	d.started = time.Now()
+	d.timer.Reset(d.remaining)
+	d.paused = false
+}
+
+func (d *downloadDeadline) stop() {
+	if d != nil {
+		d.timer.Stop()
+	}
+}
+
+// explain replaces the error from a request or read the deadline cut short with one saying it timed out.
+func (d *downloadDeadline) explain(err error) error {
+	if d != nil && d.expired.Load() {
+		return fmt.Errorf("download timed out after %s", d.timeout)
+	}
+	return err
 }
 
 type progressReader struct {
@@ -750,12 +1487,17 @@ type progressReader struct {
 	bytesRead      int64
 	lastReported   int64
 	reportInterval int64
+	limiters       []*rateLimiter // nil entries are unlimited
 }
 
 func (r *progressReader) Read(p []byte) (n int, err error) {
 	n, err = r.reader.Read(p)
 	r.bytesRead += int64(n)
 
+	for _, limiter := range r.limiters {
+		limiter.wait(n)
+	}
+
 	if r.bytesRead-r.lastReported >= r.reportInterval {
 		if r.onProgress != nil {
 			r.onProgress(r.bytesRead)
@@ -769,3 +1511,45 @@ func (r *progressReader) Read(p []byte) (n int, err error) {
 
 	return
 }
+
+// rateLimiter is a token bucket that lets through rate bytes per second on average, with bursts of up to one second.
+// It is safe to share between downloads.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   int64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns nil, which never waits, when rate is 0 or less.
+func newRateLimiter(rate int64) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &rateLimiter{rate: rate, tokens: float64(rate), last: time.Now()}
+}
+
+// wait takes n bytes from the bucket, sleeping until the bucket has refilled enough to cover them.
+func (l *rateLimiter) wait(n int) {
+	if l == nil || n <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * float64(l.rate)
+	if l.tokens > float64(l.rate) {
+		l.tokens = float64(l.rate)
+	}
+	l.last = now
+
+	// Going into debt lets large reads through and makes the following ones wait for the refill
+	l.tokens -= float64(n)
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / float64(l.rate) * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	time.Sleep(delay)
+}
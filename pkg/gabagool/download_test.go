@@ -0,0 +1,316 @@
+package gabagool
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testFileServer serves each body in turn, repeating the last one, with ETag etag if set. It records every
+// request's headers.
+type testFileServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	bodies   [][]byte
+	etag     string
+	requests []http.Header
+}
+
+func newTestFileServer(t *testing.T, etag string, bodies ...[]byte) *testFileServer {
+	s := &testFileServer{bodies: bodies, etag: etag}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		body := s.bodies[min(len(s.requests), len(s.bodies)-1)]
+		s.requests = append(s.requests, r.Header.Clone())
+		s.mu.Unlock()
+
+		if s.etag != "" {
+			w.Header().Set("ETag", s.etag)
+		}
+		http.ServeContent(w, r, "file.bin", time.Time{}, bytes.NewReader(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testFileServer) request(i int) http.Header {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i >= len(s.requests) {
+		return nil
+	}
+	return s.requests[i]
+}
+
+func testContent() []byte {
+	return bytes.Repeat([]byte("0123456789abcdef"), 512)
+}
+
+func newTestJob(download Download) *downloadJob {
+	return &downloadJob{
+		download:   download,
+		timeout:    5 * time.Second,
+		cancelChan: make(chan struct{}),
+		pauseChan:  make(chan struct{}, 1),
+	}
+}
+
+func newTestDownloadManager() *downloadManager {
+	return &downloadManager{retryChan: make(chan *downloadJob, 1)}
+}
+
+// writePartial leaves behind what an interrupted download would have: the partial file and, if set, its validator.
+func writePartial(t *testing.T, location string, data []byte, validator string) {
+	t.Helper()
+	if err := os.WriteFile(location+partialSuffix, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if validator != "" {
+		if err := writeValidator(location+partialSuffix+validatorSuffix, validator); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// assertDownloaded checks that the job finished with want at its location and cleaned up its partial files.
+func assertDownloaded(t *testing.T, job *downloadJob, want []byte) {
+	t.Helper()
+	if !job.isComplete {
+		t.Fatalf("job did not complete: %v", job.error)
+	}
+
+	got, err := os.ReadFile(job.download.Location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("downloaded %d bytes that don't match the %d served", len(got), len(want))
+	}
+
+	for _, leftover := range []string{partialSuffix, partialSuffix + validatorSuffix} {
+		if _, err := os.Stat(job.download.Location + leftover); !os.IsNotExist(err) {
+			t.Errorf("%s was not removed", filepath.Base(job.download.Location+leftover))
+		}
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter := newRateLimiter(0)
+	if limiter != nil {
+		t.Fatalf("newRateLimiter(0) = %v, want nil", limiter)
+	}
+
+	start := time.Now()
+	limiter.wait(1 << 30)
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("nil limiter waited %v", elapsed)
+	}
+}
+
+func TestRateLimiterThrottlesAfterBurst(t *testing.T) {
+	limiter := newRateLimiter(1000)
+
+	start := time.Now()
+	limiter.wait(1000)
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("first second's burst waited %v", elapsed)
+	}
+
+	start = time.Now()
+	limiter.wait(250)
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("250 bytes past the burst at 1000 B/s waited %v, want about 250ms", elapsed)
+	}
+}
+
+func TestPausableReaderBlocksUntilResumed(t *testing.T) {
+	job := newTestJob(Download{})
+	job.paused = true
+	reader := &pausableReader{reader: strings.NewReader("data"), job: job}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := io.ReadAll(reader)
+		done <- err
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("read finished while the job was paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	newTestDownloadManager().resumeJob(job)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("read after resuming: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("read did not resume")
+	}
+}
+
+func TestPausableReaderStopsWhenCancelled(t *testing.T) {
+	job := newTestJob(Download{})
+	job.paused = true
+	reader := &pausableReader{reader: strings.NewReader("data"), job: job}
+
+	close(job.cancelChan)
+
+	if _, err := reader.Read(make([]byte, 4)); err == nil {
+		t.Error("read from a cancelled job did not fail")
+	}
+}
+
+func TestDownloadDeadlineSuspendedWhilePaused(t *testing.T) {
+	var cancelled atomic.Bool
+	deadline := newDownloadDeadline(100*time.Millisecond, func() { cancelled.Store(true) })
+	defer deadline.stop()
+
+	deadline.pause()
+	time.Sleep(150 * time.Millisecond)
+	if cancelled.Load() {
+		t.Fatal("deadline expired while paused")
+	}
+
+	deadline.resume()
+	time.Sleep(150 * time.Millisecond)
+	if !cancelled.Load() {
+		t.Fatal("deadline did not expire after resuming")
+	}
+	if err := deadline.explain(errors.New("read failed")); !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("explain = %v, want a timeout error", err)
+	}
+}
+
+func TestDownloadResumesPartialFile(t *testing.T) {
+	content := testContent()
+	server := newTestFileServer(t, `"v1"`, content)
+	location := filepath.Join(t.TempDir(), "file.bin")
+	writePartial(t, location, content[:1000], `"v1"`)
+
+	job := newTestJob(Download{URL: server.URL, Location: location})
+	newTestDownloadManager().downloadFile(job)
+
+	request := server.request(0)
+	if got := request.Get("Range"); got != "bytes=1000-" {
+		t.Errorf("Range = %q, want %q", got, "bytes=1000-")
+	}
+	if got := request.Get("If-Range"); got != `"v1"` {
+		t.Errorf("If-Range = %q, want %q", got, `"v1"`)
+	}
+	assertDownloaded(t, job, content)
+}
+
+func TestDownloadRestartsWhenFileChanged(t *testing.T) {
+	content := testContent()
+	server := newTestFileServer(t, `"v2"`, content)
+	location := filepath.Join(t.TempDir(), "file.bin")
+	writePartial(t, location, []byte("stale partial data"), `"v1"`)
+
+	job := newTestJob(Download{URL: server.URL, Location: location})
+	newTestDownloadManager().downloadFile(job)
+
+	assertDownloaded(t, job, content)
+}
+
+func TestDownloadRestartsWhenRangeNotSatisfiable(t *testing.T) {
+	content := testContent()
+	server := newTestFileServer(t, `"v1"`, content)
+	location := filepath.Join(t.TempDir(), "file.bin")
+	writePartial(t, location, append(testContent(), "extra"...), `"v1"`)
+
+	job := newTestJob(Download{URL: server.URL, Location: location})
+	newTestDownloadManager().downloadFile(job)
+
+	if retry := server.request(1); retry == nil || retry.Get("Range") != "" {
+		t.Errorf("download was not restarted without a Range after the 416")
+	}
+	assertDownloaded(t, job, content)
+}
+
+func TestDownloadWithoutValidatorStartsOver(t *testing.T) {
+	content := testContent()
+	server := newTestFileServer(t, "", content)
+	location := filepath.Join(t.TempDir(), "file.bin")
+	writePartial(t, location, content[:1000], "")
+
+	job := newTestJob(Download{URL: server.URL, Location: location})
+	newTestDownloadManager().downloadFile(job)
+
+	if got := server.request(0).Get("Range"); got != "" {
+		t.Errorf("Range = %q, want none without a validator", got)
+	}
+	assertDownloaded(t, job, content)
+}
+
+func TestDownloadRetriesChecksumMismatch(t *testing.T) {
+	content := testContent()
+	sum := sha256.Sum256(content)
+	server := newTestFileServer(t, `"v1"`, []byte("corrupt"), content)
+	location := filepath.Join(t.TempDir(), "file.bin")
+
+	dm := newTestDownloadManager()
+	dm.verifyChecksum = true
+	dm.checksumRetries = 1
+	job := newTestJob(Download{URL: server.URL, Location: location, Checksum: hex.EncodeToString(sum[:])})
+	dm.activeJobs = []*downloadJob{job}
+
+	dm.downloadFile(job)
+
+	if !errors.Is(job.error, ErrChecksumMismatch) {
+		t.Fatalf("error = %v, want ErrChecksumMismatch", job.error)
+	}
+	if _, err := os.Stat(location + partialSuffix); !os.IsNotExist(err) {
+		t.Error("corrupt partial file was not removed")
+	}
+
+	dm.updateJobStatus()
+
+	if job.retries != 1 {
+		t.Errorf("retries = %d, want 1", job.retries)
+	}
+	select {
+	case retried := <-dm.retryChan:
+		if retried != job {
+			t.Fatal("a different job was retried")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("checksum mismatch was not retried")
+	}
+
+	dm.downloadFile(job)
+
+	assertDownloaded(t, job, content)
+}
+
+func TestDownloadFailsAfterChecksumRetries(t *testing.T) {
+	server := newTestFileServer(t, `"v1"`, []byte("corrupt"))
+	location := filepath.Join(t.TempDir(), "file.bin")
+
+	dm := newTestDownloadManager()
+	dm.verifyChecksum = true
+	job := newTestJob(Download{URL: server.URL, Location: location, Checksum: strings.Repeat("0", 64)})
+	dm.activeJobs = []*downloadJob{job}
+
+	dm.downloadFile(job)
+	dm.updateJobStatus()
+
+	if len(dm.failedDownloads) != 1 || len(dm.activeJobs) != 0 {
+		t.Errorf("failed = %d, active = %d, want the download to fail without retrying", len(dm.failedDownloads), len(dm.activeJobs))
+	}
+}
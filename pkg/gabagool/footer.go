@@ -1,8 +1,10 @@
 package gabagool
 
 import (
+	"strings"
 	"sync/atomic"
 
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
 	"github.com/veandco/go-sdl2/gfx"
 	"github.com/veandco/go-sdl2/sdl"
@@ -14,18 +16,28 @@ import (
 // HelpText is the text that will be displayed in the outer pill to the right of the button.
 // IsConfirmButton marks this item as the confirm/start button, which can be hidden in multiselect mode when nothing is selected.
 // Show is an optional atomic boolean that controls visibility. When not nil and false, the item is not rendered.
+// Disabled keeps the item in place but draws it muted, and components ignore the matching button while it is set.
 type FooterHelpItem struct {
 	HelpText        string
 	ButtonName      string
 	IsConfirmButton bool
 	Show            *atomic.Bool
+	Disabled        bool
 }
 
+// defaultFooterMargins returns the footer margins for screens without margins of their own:
+// 20 pixels grown by the safe-area inset.
+func defaultFooterMargins() internal.Padding {
+	return internal.UniformPadding(20).WithSafeArea()
+}
+
+// renderFooter draws the footer pills inside margins, which already include the safe-area inset as component
+// margins do. The left group sits at margins.Left, the right group at margins.Right, both above margins.Bottom.
 func renderFooter(
 	renderer *sdl.Renderer,
 	font *ttf.Font,
 	footerHelpItems []FooterHelpItem,
-	bottomPadding int32,
+	margins internal.Padding,
 	transparentBackground bool,
 	centerSingleItem bool,
 ) {
@@ -33,15 +45,7 @@ func renderFooter(
 		return
 	}
 
-	// Filter out items where Show is not nil and false
-	visibleItems := make([]FooterHelpItem, 0, len(footerHelpItems))
-	for _, item := range footerHelpItems {
-		if item.Show != nil && !item.Show.Load() {
-			continue
-		}
-		visibleItems = append(visibleItems, item)
-	}
-	footerHelpItems = visibleItems
+	footerHelpItems = visibleFooterItems(footerHelpItems)
 
 	if len(footerHelpItems) == 0 {
 		return
@@ -49,17 +53,18 @@ func renderFooter(
 
 	scaleFactor := internal.GetScaleFactor()
 	window := internal.GetWindow()
-	windowWidth, windowHeight := window.Window.GetSize()
-	y := windowHeight - bottomPadding - int32(float32(50)*scaleFactor)
-	outerPillHeight := int32(float32(60) * scaleFactor)
+	windowWidth, windowHeight := window.GetWidth(), window.GetHeight()
+	fontScale := internal.GetFontScale()
+	y := windowHeight - margins.Bottom - int32(float32(50)*scaleFactor*fontScale)
+	outerPillHeight := int32(float32(60) * scaleFactor * fontScale)
 
 	if !transparentBackground {
 		// Add a black background for the entire footer area
 		footerBackgroundRect := &sdl.Rect{
-			X: 0,                                                // Start from left edge
-			Y: y - 10,                                           // Same Y as the pills
-			W: windowWidth - 15,                                 // Full window.GetWidth()
-			H: outerPillHeight + int32(float32(50)*scaleFactor), // Same height as the pills
+			X: 0,                                                          // Start from left edge
+			Y: y - 10,                                                     // Same Y as the pills
+			W: windowWidth - 15,                                           // Full window.GetWidth()
+			H: outerPillHeight + int32(float32(50)*scaleFactor*fontScale), // Same height as the pills
 		}
 
 		renderer.SetDrawColor(0, 0, 0, 255)
@@ -67,25 +72,7 @@ func renderFooter(
 	}
 
 	innerPillMargin := int32(float32(6) * scaleFactor)
-	var leftItems []FooterHelpItem
-	var rightItems []FooterHelpItem
-	switch len(footerHelpItems) {
-	case 1:
-		// For a single item, center it
-		leftItems = footerHelpItems[0:1]
-	case 2:
-		leftItems = footerHelpItems[0:1]
-		rightItems = footerHelpItems[1:2]
-	case 3:
-		leftItems = footerHelpItems[0:2]
-		rightItems = footerHelpItems[2:3]
-	case 4, 5, 6:
-		leftItems = footerHelpItems[0:2]
-		rightItems = footerHelpItems[2:min(4, len(footerHelpItems))]
-	default:
-		leftItems = footerHelpItems[0:2]
-		rightItems = footerHelpItems[2:4]
-	}
+	leftItems, rightItems := splitFooterItems(footerHelpItems)
 
 	if len(leftItems) > 0 {
 		if len(footerHelpItems) == 1 && centerSingleItem {
@@ -93,16 +80,96 @@ func renderFooter(
 			centerX := (windowWidth - pillWidth) / 2
 			renderGroupAsContinuousPill(renderer, font, leftItems, centerX, y, outerPillHeight, innerPillMargin)
 		} else {
-			renderGroupAsContinuousPill(renderer, font, leftItems, bottomPadding, y, outerPillHeight, innerPillMargin)
+			renderGroupAsContinuousPill(renderer, font, leftItems, margins.Left, y, outerPillHeight, innerPillMargin)
 		}
 	}
 	if len(rightItems) > 0 {
 		rightGroupWidth := calculateContinuousPillWidth(font, rightItems, outerPillHeight, innerPillMargin)
-		rightX := windowWidth - bottomPadding - rightGroupWidth
+		rightX := windowWidth - margins.Right - rightGroupWidth
 		renderGroupAsContinuousPill(renderer, font, rightItems, rightX, y, outerPillHeight, innerPillMargin)
 	}
 }
 
+// MeasureFooter returns the widths of the left and right footer pill groups for the given items
+// and the height the footer occupies above the bottom edge of the screen.
+// The groups are pinned to the left and right margins, so the gap between them is whatever width is left over;
+// right is 0 when all items fit in the left group.
+// Hidden items (Show set to false) are ignored. Returns 0, 0, 0 when nothing would be drawn.
+func MeasureFooter(items []FooterHelpItem) (left, right, h int32) {
+	items = visibleFooterItems(items)
+	if len(items) == 0 {
+		return 0, 0, 0
+	}
+
+	scaleFactor := internal.GetScaleFactor()
+	fontScale := internal.GetFontScale()
+	font := internal.Fonts.SmallFont
+	outerPillHeight := int32(float32(60) * scaleFactor * fontScale)
+	innerPillMargin := int32(float32(6) * scaleFactor)
+
+	leftItems, rightItems := splitFooterItems(items)
+	if len(leftItems) > 0 {
+		left = calculateContinuousPillWidth(font, leftItems, outerPillHeight, innerPillMargin)
+	}
+	if len(rightItems) > 0 {
+		right = calculateContinuousPillWidth(font, rightItems, outerPillHeight, innerPillMargin)
+	}
+
+	h = defaultFooterMargins().Bottom + int32(float32(50)*scaleFactor*fontScale)
+
+	return left, right, h
+}
+
+// visibleFooterItems filters out items where Show is not nil and false
+func visibleFooterItems(items []FooterHelpItem) []FooterHelpItem {
+	visibleItems := make([]FooterHelpItem, 0, len(items))
+	for _, item := range items {
+		if item.Show != nil && !item.Show.Load() {
+			continue
+		}
+		visibleItems = append(visibleItems, item)
+	}
+	return visibleItems
+}
+
+// footerButtonDisabled reports whether a visible footer item for the given button is marked Disabled
+func footerButtonDisabled(items []FooterHelpItem, button constants.VirtualButton) bool {
+	for _, item := range visibleFooterItems(items) {
+		if item.Disabled && strings.EqualFold(item.ButtonName, button.GetName()) {
+			return true
+		}
+	}
+	return false
+}
+
+// mutedColor dims a color so disabled footer items read as unavailable
+func mutedColor(c sdl.Color) sdl.Color {
+	return sdl.Color{R: c.R / 5 * 2, G: c.G / 5 * 2, B: c.B / 5 * 2, A: c.A}
+}
+
+// splitFooterItems divides items into the left and right pill groups
+func splitFooterItems(items []FooterHelpItem) (leftItems, rightItems []FooterHelpItem) {
+	switch len(items) {
+	case 0:
+	case 1:
+		// For a single item, center it
+		leftItems = items[0:1]
+	case 2:
+		leftItems = items[0:1]
+		rightItems = items[1:2]
+	case 3:
+		leftItems = items[0:2]
+		rightItems = items[2:3]
+	case 4, 5, 6:
+		leftItems = items[0:2]
+		rightItems = items[2:min(4, len(items))]
+	default:
+		leftItems = items[0:2]
+		rightItems = items[2:4]
+	}
+	return leftItems, rightItems
+}
+
 func calculateContinuousPillWidth(font *ttf.Font, items []FooterHelpItem, outerPillHeight, innerPillMargin int32) int32 {
 	scaleFactor := internal.GetScaleFactor()
 	var totalWidth = int32(float32(10) * scaleFactor)
@@ -176,12 +243,21 @@ func renderGroupAsContinuousPill(
 	rightPadding := int32(float32(30) * paddingFactor)
 
 	for _, item := range items {
-		buttonSurface, err := font.RenderUTF8Blended(item.ButtonName, internal.GetTheme().ButtonLabelColor)
+		labelColor := internal.GetTheme().ButtonLabelColor
+		hintColor := internal.GetTheme().HintColor
+		buttonColor := internal.GetTheme().HighlightColor
+		if item.Disabled {
+			labelColor = mutedColor(labelColor)
+			hintColor = mutedColor(hintColor)
+			buttonColor = mutedColor(buttonColor)
+		}
+
+		buttonSurface, err := font.RenderUTF8Blended(item.ButtonName, labelColor)
 		if err != nil || buttonSurface == nil {
 			continue
 		}
 
-		helpSurface, err := font.RenderUTF8Blended(item.HelpText, internal.GetTheme().HintColor)
+		helpSurface, err := font.RenderUTF8Blended(item.HelpText, hintColor)
 		if err != nil || helpSurface == nil {
 			buttonSurface.Free()
 			continue
@@ -191,7 +267,7 @@ func renderGroupAsContinuousPill(
 		isCircle := innerPillWidth == innerPillHeight
 
 		if isCircle {
-			drawCircleShape(renderer, currentX+innerPillHeight/2, y+innerPillMargin+innerPillHeight/2, innerPillHeight/2, internal.GetTheme().HighlightColor)
+			drawCircleShape(renderer, currentX+innerPillHeight/2, y+innerPillMargin+innerPillHeight/2, innerPillHeight/2, buttonColor)
 		} else {
 			innerPillRect := &sdl.Rect{
 				X: currentX,
@@ -200,7 +276,7 @@ func renderGroupAsContinuousPill(
 				H: innerPillHeight,
 			}
 			cornerRadiusInner := innerPillHeight / 2
-			internal.DrawRoundedRect(renderer, innerPillRect, cornerRadiusInner, internal.GetTheme().HighlightColor)
+			internal.DrawRoundedRect(renderer, innerPillRect, cornerRadiusInner, buttonColor)
 		}
 
 		buttonTexture, err := renderer.CreateTextureFromSurface(buttonSurface)
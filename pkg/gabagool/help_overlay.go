@@ -27,7 +27,7 @@ type helpOverlay struct {
 
 func newHelpOverlay(title string, lines []string, exitText string) *helpOverlay {
 	window := internal.GetWindow()
-	width, height := window.Window.GetSize()
+	width, height := window.GetWidth(), window.GetHeight()
 
 	if title == "" {
 		title = "Help"
@@ -0,0 +1,33 @@
+package gabagool
+
+import (
+	"time"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+)
+
+// HoldEvent reports that a button registered with RegisterHold was held long enough
+type HoldEvent = internal.HoldEvent
+
+// RegisterHold calls onHold once when button is held for duration without being released.
+// onHold may be nil if you only poll ProcessHoldEvent.
+//
+// Example:
+//
+//	gabagool.RegisterHold(constants.VirtualButtonB, 2*time.Second, func() {
+//	    os.Exit(0)
+//	})
+func RegisterHold(button constants.VirtualButton, duration time.Duration, onHold func()) {
+	internal.GetInputProcessor().RegisterHold(button, duration, onHold)
+}
+
+// UnregisterHold removes hold detection from a button
+func UnregisterHold(button constants.VirtualButton) {
+	internal.GetInputProcessor().UnregisterHold(button)
+}
+
+// ProcessHoldEvent returns the next queued hold event, or nil if none are pending.
+func ProcessHoldEvent() *HoldEvent {
+	return internal.GetInputProcessor().ProcessHoldEvent()
+}
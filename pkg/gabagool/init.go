@@ -1,6 +1,7 @@
 package gabagool
 
 import (
+	"errors"
 	"log/slog"
 	"os"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/platform/cannoli"
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/platform/nextui"
+	"github.com/veandco/go-sdl2/sdl"
 )
 
 type Options struct {
@@ -18,6 +20,15 @@ type Options struct {
 	IsNextUI             bool
 	ControllerConfigFile string
 	LogFilename          string
+	GradientBackground   GradientBackground // Replaces the background image with a vertical gradient when set
+	TargetFPS            int                // Caps the frame rate of component loops (default: about 60)
+	DisableVSync         bool               // Presents without waiting for the display's refresh, which may tear
+}
+
+// GradientBackground is a vertical gradient drawn behind components in place of the background image.
+type GradientBackground struct {
+	Top    sdl.Color
+	Bottom sdl.Color
 }
 
 // Init initializes SDL and the UI
@@ -27,7 +38,7 @@ func Init(options Options) {
 		internal.SetLogFilename(options.LogFilename)
 	}
 
-	if os.Getenv("NITRATES") != "" || os.Getenv("INPUT_CAPTURE") != "" {
+	if os.Getenv("NITRATES") != "" || os.Getenv("INPUT_CAPTURE") != "" || debugStateEnabled {
 		internal.SetInternalLogLevel(slog.LevelDebug)
 	} else {
 		internal.SetInternalLogLevel(slog.LevelError)
@@ -58,8 +69,15 @@ func Init(options Options) {
 		internal.SetTheme(theme)
 	}
 
+	internal.SetVSync(!options.DisableVSync)
+	internal.SetTargetFPS(options.TargetFPS)
+
 	internal.Init(options.WindowTitle, options.ShowBackground, pbc)
 
+	if options.GradientBackground != (GradientBackground{}) {
+		internal.GetWindow().SetGradientBackground(options.GradientBackground.Top, options.GradientBackground.Bottom)
+	}
+
 	if os.Getenv("INPUT_CAPTURE") != "" {
 		mapping := InputLogger()
 		if mapping != nil {
@@ -72,6 +90,20 @@ func Init(options Options) {
 	}
 }
 
+// EnableDebugOverlay shows the frame rate, last button pressed, selected index and keyboard text length
+// in the top-right corner of every component, for diagnosing performance and input issues.
+func EnableDebugOverlay(enabled bool) {
+	internal.DebugOverlay.SetEnabled(enabled)
+}
+
+// UseTestMode sets up a headless window, with no display, for unit testing component logic.
+// It uses ThemeDark and the built-in fonts, so tests don't depend on a device's files.
+// Call it instead of Init, and Close when done.
+func UseTestMode() error {
+	internal.SetTheme(ThemeDark)
+	return internal.UseTestMode()
+}
+
 // Close Tidies up SDL and the UI
 // Must be called after all UI functions!
 func Close() {
@@ -98,10 +130,93 @@ func SetInputMappingBytes(data []byte) {
 	internal.SetInputMappingBytes(data)
 }
 
+// FontConfig supplies custom fonts to SetFonts.
+type FontConfig = internal.FontConfig
+
+// SetFonts replaces the built-in fonts with the given TrueType fonts, so apps can use their own brand font.
+// Any font left nil keeps the built-in one. Must be called after Init.
+func SetFonts(config FontConfig) {
+	internal.SetFonts(config)
+}
+
+// SetFontScale makes all text factor times its normal size; values above 1 enlarge it for accessibility.
+// List rows grow to match. The default is 1. Must be called after Init.
+func SetFontScale(factor float32) {
+	internal.SetFontScale(factor)
+}
+
+// SetReducedMotion makes components skip animations: detail screens jump straight to their scroll position
+// and long text no longer scrolls back and forth. Useful for accessibility and on slow hardware.
+func SetReducedMotion(enabled bool) {
+	internal.SetReducedMotion(enabled)
+}
+
+// SetActivationCooldown ignores button presses for d after any component exits.
+// This stops a bouncing button or a lingering combo from instantly confirming the next screen. 0 (the default) disables it.
+func SetActivationCooldown(d time.Duration) {
+	internal.SetActivationCooldown(d)
+}
+
+// OnControllerChanged sets a function that is called with the number of connected game controllers
+// whenever one is plugged in or removed while a component is on screen.
+func OnControllerChanged(fn func(count int)) {
+	internal.GetInputProcessor().SetOptions(internal.ProcessorOptions{OnControllerChanged: fn})
+}
+
+// MousePosition returns the last known mouse cursor position in window coordinates.
+// Mouse buttons are mapped through the input mapping's MouseMap, which is handy when developing on a desktop.
+func MousePosition() (x, y int32) {
+	return internal.GetInputProcessor().MousePosition()
+}
+
+// SetSafeArea insets every component by the given amounts, on top of their own margins.
+// Useful on devices whose bezels or rounded corners clip content at the screen edges.
+func SetSafeArea(top, right, bottom, left int32) {
+	internal.SetSafeArea(internal.Padding{Top: top, Right: right, Bottom: bottom, Left: left})
+}
+
+// GetSafeArea returns the current safe-area inset.
+func GetSafeArea() internal.Padding {
+	return internal.GetSafeArea()
+}
+
+// SetImageCacheLimit caps the estimated memory used by cached image textures across all components.
+// Least recently used images are evicted once the limit is reached. 0 (the default) means no byte limit.
+func SetImageCacheLimit(bytes int64) {
+	internal.SetTextureCacheLimit(bytes)
+}
+
+// GetImageCacheUsage returns the estimated bytes and number of image textures currently cached.
+func GetImageCacheUsage() (bytes int64, textures int) {
+	return internal.GetTextureCacheUsage()
+}
+
 func GetWindow() *internal.Window {
 	return internal.GetWindow()
 }
 
+// screenshotTimeout is how long Screenshot waits for a component to draw a frame.
+const screenshotTimeout = 2 * time.Second
+
+// Screenshot saves the next frame a component draws to a PNG file, for automated UI tests or a launcher's
+// screenshot feature. The frame is captured by the component's render loop, so call Screenshot from another
+// goroutine while a component is showing; it returns an error if no frame is drawn within screenshotTimeout.
+func Screenshot(filename string) error {
+	window := internal.GetWindow()
+	result := window.RequestScreenshot(filename)
+
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(screenshotTimeout):
+		// A frame may have picked the request up just now, in which case its result is on the way
+		if !window.CancelScreenshot(result) {
+			return <-result
+		}
+		return errors.New("screenshot timed out waiting for a frame")
+	}
+}
+
 func HideWindow() {
 	internal.GetWindow().Window.Hide()
 }
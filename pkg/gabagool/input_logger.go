@@ -88,7 +88,7 @@ func InputLogger() *internal.InputMapping {
 		}
 
 		logger.render()
-		sdl.Delay(16)
+		sdl.Delay(internal.FrameDelay())
 	}
 
 	return logger.buildMapping()
@@ -293,7 +293,7 @@ func (il *inputLoggerController) render() {
 		il.renderText(renderer, escapeHint, internal.GetWindow().GetWidth()/2, internal.GetWindow().GetHeight()-80, true)
 	}
 
-	renderer.Present()
+	internal.GetWindow().Present()
 }
 
 func (il *inputLoggerController) buildMapping() *internal.InputMapping {
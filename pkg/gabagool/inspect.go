@@ -0,0 +1,88 @@
+package gabagool
+
+import (
+	"os"
+	"unicode/utf8"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+)
+
+// DebugStateEnvVar logs each component's state whenever it changes while set to "1", to diagnose navigation issues.
+const DebugStateEnvVar = "GABAGOOL_DEBUG_STATE"
+
+var debugStateEnabled = os.Getenv(DebugStateEnvVar) == "1"
+
+// lastInspections holds the last state logged per component, so unchanged frames aren't logged again
+var lastInspections = map[string]any{}
+
+// ListInspection is a snapshot of a List's navigation state.
+type ListInspection struct {
+	SelectedIndex     int
+	VisibleStartIndex int
+	MultiSelect       bool
+	ReorderMode       bool
+	ItemCount         int
+	ScrollDataCount   int
+}
+
+// OptionListInspection is a snapshot of an OptionsList's navigation state.
+type OptionListInspection struct {
+	SelectedIndex     int
+	VisibleStartIndex int
+	ItemCount         int
+	ScrollDataCount   int
+	ShowingHelp       bool
+}
+
+// KeyboardInspection is a snapshot of a Keyboard's editing state.
+type KeyboardInspection struct {
+	SelectedKeyIndex int
+	SelectedSpecial  int
+	CursorPosition   int
+	TextLength       int
+	ShowingHelp      bool
+}
+
+// InspectList snapshots a list for logging.
+func InspectList(lc *listController) ListInspection {
+	return ListInspection{
+		SelectedIndex:     lc.Options.SelectedIndex,
+		VisibleStartIndex: lc.Options.VisibleStartIndex,
+		MultiSelect:       lc.MultiSelect,
+		ReorderMode:       lc.ReorderMode,
+		ItemCount:         len(lc.Options.Items),
+		ScrollDataCount:   len(lc.itemScrollData) + len(lc.subtitleData),
+	}
+}
+
+// InspectOptionList snapshots an option list for logging.
+func InspectOptionList(olc *optionsListController) OptionListInspection {
+	return OptionListInspection{
+		SelectedIndex:     olc.SelectedIndex,
+		VisibleStartIndex: olc.VisibleStartIndex,
+		ItemCount:         len(olc.Items),
+		ScrollDataCount:   len(olc.itemScrollData),
+		ShowingHelp:       olc.ShowingHelp,
+	}
+}
+
+// InspectKeyboard snapshots a keyboard for logging.
+func InspectKeyboard(kb *virtualKeyboard) KeyboardInspection {
+	return KeyboardInspection{
+		SelectedKeyIndex: kb.SelectedKeyIndex,
+		SelectedSpecial:  kb.SelectedSpecial,
+		CursorPosition:   kb.CursorPosition,
+		TextLength:       utf8.RuneCountInString(kb.TextBuffer),
+		ShowingHelp:      kb.ShowingHelp,
+	}
+}
+
+// logInspection logs a component's state when DebugStateEnvVar is set and it differs from the last frame's.
+func logInspection(component string, inspection any) {
+	if !debugStateEnabled || lastInspections[component] == inspection {
+		return
+	}
+	lastInspections[component] = inspection
+
+	internal.GetInternalLogger().Debug("Component state", "component", component, "state", inspection)
+}
@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// DebugOverlay draws frame rate and input details over components while enabled.
+var DebugOverlay = &debugOverlay{lastButton: constants.VirtualButtonUnassigned}
+
+// DebugInfo is what a component reports to the debug overlay each frame.
+type DebugInfo struct {
+	SelectedIndex int // -1 when the component has no selection
+	TextLength    int // -1 when the component has no text buffer
+}
+
+type debugOverlay struct {
+	enabled    bool
+	lastFrame  time.Time
+	fps        float64
+	lastButton constants.VirtualButton
+}
+
+// SetEnabled turns the overlay on or off.
+func (d *debugOverlay) SetEnabled(enabled bool) {
+	d.enabled = enabled
+	d.lastFrame = time.Time{}
+	d.fps = 0
+}
+
+func (d *debugOverlay) Enabled() bool {
+	return d.enabled
+}
+
+// recordButton remembers the most recently pressed button.
+func (d *debugOverlay) recordButton(button constants.VirtualButton) {
+	d.lastButton = button
+}
+
+// Render draws the overlay in the top-right corner. Call it once per frame, last, so the frame rate is
+// measured from one call to the next.
+func (d *debugOverlay) Render(renderer *sdl.Renderer, info DebugInfo) {
+	if !d.enabled {
+		return
+	}
+
+	now := time.Now()
+	if !d.lastFrame.IsZero() {
+		if delta := now.Sub(d.lastFrame).Seconds(); delta > 0 {
+			// Smooth the reading so it doesn't flicker from frame to frame
+			if d.fps == 0 {
+				d.fps = 1 / delta
+			} else {
+				d.fps = d.fps*0.9 + (1/delta)*0.1
+			}
+		}
+	}
+	d.lastFrame = now
+
+	lines := []string{
+		fmt.Sprintf("FPS: %.0f", d.fps),
+		fmt.Sprintf("Button: %s", d.lastButton.GetName()),
+	}
+	if info.SelectedIndex >= 0 {
+		lines = append(lines, fmt.Sprintf("Selected: %d", info.SelectedIndex))
+	}
+	if info.TextLength >= 0 {
+		lines = append(lines, fmt.Sprintf("Text length: %d", info.TextLength))
+	}
+
+	font := Fonts.TinyFont
+	padding := int32(float32(8) * GetScaleFactor())
+	lineHeight := int32(font.Height())
+
+	width := int32(0)
+	for _, line := range lines {
+		if w, _, err := font.SizeUTF8(line); err == nil && int32(w) > width {
+			width = int32(w)
+		}
+	}
+
+	box := sdl.Rect{
+		W: width + padding*2,
+		H: lineHeight*int32(len(lines)) + padding*2,
+	}
+	box.X = GetWindow().GetWidth() - box.W - padding
+	box.Y = padding
+
+	var blendMode sdl.BlendMode
+	renderer.GetDrawBlendMode(&blendMode)
+	renderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
+	renderer.SetDrawColor(0, 0, 0, 180)
+	renderer.FillRect(&box)
+	renderer.SetDrawBlendMode(blendMode)
+
+	y := box.Y + padding
+	for _, line := range lines {
+		d.renderLine(renderer, line, box.X+padding, y)
+		y += lineHeight
+	}
+}
+
+// renderLine draws one line of the overlay. The readings change every frame, so they are rendered directly
+// rather than through Glyphs, where they would push out the labels components redraw each frame.
+func (d *debugOverlay) renderLine(renderer *sdl.Renderer, line string, x, y int32) {
+	surface, err := Fonts.TinyFont.RenderUTF8Blended(line, sdl.Color{R: 0, G: 255, B: 0, A: 255})
+	if err != nil {
+		return
+	}
+	defer surface.Free()
+
+	texture, err := renderer.CreateTextureFromSurface(surface)
+	if err != nil {
+		return
+	}
+	defer texture.Destroy()
+
+	renderer.Copy(texture, nil, &sdl.Rect{X: x, Y: y, W: surface.W, H: surface.H})
+}
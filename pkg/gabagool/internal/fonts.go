@@ -67,6 +67,24 @@ type fontsManager struct {
 	MicroFont      *ttf.Font
 }
 
+// FontConfig replaces the built-in fonts. Sizes are base sizes at 1024px wide and are scaled for the screen
+// like the built-in ones. A nil font uses the built-in font; a zero size keeps the default size.
+type FontConfig struct {
+	SmallTTF      []byte
+	MediumTTF     []byte
+	LargeTTF      []byte
+	ExtraLargeTTF []byte
+
+	SmallSize      int
+	MediumSize     int
+	LargeSize      int
+	ExtraLargeSize int
+}
+
+// customFonts holds the config passed to SetFonts. Keeping it also keeps the font data alive for SDL,
+// which reads from it for as long as the fonts are open.
+var customFonts FontConfig
+
 func CalculateFontSizeForResolution(baseSize int, screenWidth int32) int {
 	const referenceWidth int32 = 1024
 	scaleFactor := float32(screenWidth) / float32(referenceWidth)
@@ -94,25 +112,97 @@ func GetScaleFactor() float32 {
 	return scaleFactor
 }
 
+// Base font sizes and the accessibility scale applied on top of them. Each scale's fonts are loaded once
+// and cached, so switching back and forth is cheap.
+var (
+	fontSizes = DefaultFontSizes
+	fontScale = float32(1)
+	fontCache = map[float32]*fontsManager{}
+)
+
 func initFonts(sizes FontSizes) {
+	fontSizes = sizes
+	Fonts = *fontSetForScale(fontScale)
+}
+
+// SetFonts replaces the small, medium, large and extra large fonts. The tiny and micro fonts are unchanged.
+// Fonts that fail to load fall back to the built-in font. Call it after Init, between components.
+func SetFonts(config FontConfig) {
+	customFonts = config
+	reloadFonts()
+}
+
+// reloadFonts drops every loaded font and loads the current scale again, for when font files or the
+// screen size they are sized for change.
+func reloadFonts() {
+	Glyphs.Clear()
+	closeFontCache()
+	Fonts = *fontSetForScale(fontScale)
+}
+
+// SetFontScale makes all text factor times its normal size, e.g. 1.25 for larger, easier to read text.
+// Call it after Init, between components.
+func SetFontScale(factor float32) {
+	if factor <= 0 {
+		factor = 1
+	}
+	fontScale = factor
+	Fonts = *fontSetForScale(factor)
+}
+
+// GetFontScale returns the factor set by SetFontScale. Layouts multiply text-sized heights by it.
+func GetFontScale() float32 {
+	return fontScale
+}
+
+func fontSetForScale(scale float32) *fontsManager {
+	if set, ok := fontCache[scale]; ok {
+		return set
+	}
+
+	set := loadFontSet(scale)
+	fontCache[scale] = set
+	return set
+}
+
+func loadFontSet(scale float32) *fontsManager {
 	screenWidth := GetWindow().GetWidth()
 	fallback := os.Getenv("FALLBACK_FONT")
 
-	// Calculate all sizes
-	calcSize := func(base int) int {
-		return CalculateFontSizeForResolution(base, screenWidth)
+	load := func(data []byte, customSize, baseSize int) *ttf.Font {
+		if customSize > 0 {
+			baseSize = customSize
+		}
+		size := int(float32(CalculateFontSizeForResolution(baseSize, screenWidth)) * scale)
+
+		if len(data) > 0 {
+			font, err := openFontBytes(data, size)
+			if err == nil {
+				return font
+			}
+			GetInternalLogger().Error("Failed to load custom font, using built-in font", "size", size, "error", err)
+		}
+		return loadFont(fallback, size)
 	}
 
-	Fonts = fontsManager{
-		ExtraLargeFont: loadFont(fallback, calcSize(sizes.XLarge)),
-		LargeFont:      loadFont(fallback, calcSize(sizes.Large)),
-		MediumFont:     loadFont(fallback, calcSize(sizes.Medium)),
-		SmallFont:      loadFont(fallback, calcSize(sizes.Small)),
-		TinyFont:       loadFont(fallback, calcSize(sizes.Tiny)),
-		MicroFont:      loadFont(fallback, calcSize(sizes.Micro)),
+	return &fontsManager{
+		ExtraLargeFont: load(customFonts.ExtraLargeTTF, customFonts.ExtraLargeSize, fontSizes.XLarge),
+		LargeFont:      load(customFonts.LargeTTF, customFonts.LargeSize, fontSizes.Large),
+		MediumFont:     load(customFonts.MediumTTF, customFonts.MediumSize, fontSizes.Medium),
+		SmallFont:      load(customFonts.SmallTTF, customFonts.SmallSize, fontSizes.Small),
+		TinyFont:       load(nil, 0, fontSizes.Tiny),
+		MicroFont:      load(nil, 0, fontSizes.Micro),
 	}
 }
 
+func openFontBytes(data []byte, size int) (*ttf.Font, error) {
+	rw, err := sdl.RWFromMem(data)
+	if err != nil {
+		return nil, err
+	}
+	return ttf.OpenFontRW(rw, 1, size)
+}
+
 func loadFont(fallback string, size int) *ttf.Font {
 	var font *ttf.Font
 	var err error
@@ -149,9 +239,17 @@ func loadEmbeddedFont(bytes []byte, size int) *ttf.Font {
 }
 
 func closeFonts() {
-	Fonts.LargeFont.Close()
-	Fonts.MediumFont.Close()
-	Fonts.SmallFont.Close()
-	Fonts.TinyFont.Close()
-	Fonts.MicroFont.Close()
+	closeFontCache()
+}
+
+func closeFontCache() {
+	for scale, set := range fontCache {
+		set.ExtraLargeFont.Close()
+		set.LargeFont.Close()
+		set.MediumFont.Close()
+		set.SmallFont.Close()
+		set.TinyFont.Close()
+		set.MicroFont.Close()
+		delete(fontCache, scale)
+	}
 }
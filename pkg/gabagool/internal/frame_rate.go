@@ -0,0 +1,54 @@
+package internal
+
+import "time"
+
+// defaultFrameDelay is the wait between frames when no target frame rate is set, about 60 FPS.
+const defaultFrameDelay = 16
+
+var (
+	vsync       = true
+	frameBudget time.Duration
+	nextFrame   time.Time
+)
+
+// SetVSync sets whether the renderer waits for the display's refresh when presenting, which is the default.
+// It must be called before Init.
+func SetVSync(enabled bool) {
+	vsync = enabled
+}
+
+// SetTargetFPS caps component loops at fps frames per second. 0 goes back to the default of about 60.
+func SetTargetFPS(fps int) {
+	if fps <= 0 {
+		frameBudget = 0
+		return
+	}
+	frameBudget = time.Second / time.Duration(fps)
+	nextFrame = time.Time{}
+}
+
+// FrameDelay returns how many milliseconds to wait before the next frame.
+// Frames are scheduled on a fixed cadence, so time lost to rounding to whole milliseconds on one frame
+// is made up on the next instead of the frame rate drifting low.
+func FrameDelay() uint32 {
+	if frameBudget == 0 {
+		return defaultFrameDelay
+	}
+
+	now := time.Now()
+	nextFrame = nextFrame.Add(frameBudget)
+
+	// Don't try to catch up after a slow frame, and don't wait longer than one frame
+	if nextFrame.Before(now) {
+		nextFrame = now
+	} else if nextFrame.After(now.Add(frameBudget)) {
+		nextFrame = now.Add(frameBudget)
+	}
+
+	return uint32(nextFrame.Sub(now).Milliseconds())
+}
+
+// FrameTimeout is FrameDelay for sdl.WaitEventTimeout.
+func FrameTimeout() int {
+	return int(FrameDelay())
+}
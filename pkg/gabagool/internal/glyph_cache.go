@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+const defaultGlyphCacheSize = 256
+
+// Glyphs is the shared cache of rendered text used by components that redraw the same labels every frame.
+var Glyphs = NewGlyphCache(defaultGlyphCacheSize)
+
+// GlyphCache keeps rendered text textures keyed by font, text and color, evicting the least recently used
+// once it holds maxEntries. It doesn't count against the image cache byte limit, so images can't crowd labels out.
+// Textures it returns belong to the cache and must not be destroyed by the caller.
+type GlyphCache struct {
+	textures *TextureCache
+}
+
+func NewGlyphCache(maxEntries int) *GlyphCache {
+	return &GlyphCache{textures: newUnbudgetedTextureCache(maxEntries)}
+}
+
+// Texture returns the texture for text drawn in font and color, rendering it on a miss. Returns nil if the
+// text can't be rendered, e.g. when it is empty.
+func (g *GlyphCache) Texture(renderer *sdl.Renderer, font *ttf.Font, text string, color sdl.Color) *sdl.Texture {
+	if text == "" {
+		return nil
+	}
+
+	key := fmt.Sprintf("%p|%02x%02x%02x%02x|%s", font, color.R, color.G, color.B, color.A, text)
+	if texture := g.textures.Get(key); texture != nil {
+		return texture
+	}
+
+	surface, err := font.RenderUTF8Blended(text, color)
+	if err != nil {
+		return nil
+	}
+	defer surface.Free()
+
+	texture, err := renderer.CreateTextureFromSurface(surface)
+	if err != nil {
+		return nil
+	}
+
+	g.textures.Set(key, texture)
+	return texture
+}
+
+// Clear destroys every cached texture. Fonts must not be closed while the cache still holds their text,
+// since a new font could reuse the address.
+func (g *GlyphCache) Clear() {
+	g.textures.Destroy()
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -288,6 +289,35 @@ func DrawRoundedRect(renderer *sdl.Renderer, rect *sdl.Rect, radius int32, color
 	gfx.RoundedRectangleColor(renderer, x1, y1, x2, y2, radius, color)
 }
 
+// RenderToTexture runs fn with a new w×h texture as the render target and returns the texture, so content that
+// rarely changes can be drawn once and copied each frame. The texture starts transparent and blends when copied.
+// The previous render target and draw color are restored afterwards. The caller owns the returned texture.
+func RenderToTexture(renderer *sdl.Renderer, w, h int32, fn func(*sdl.Renderer)) (*sdl.Texture, error) {
+	texture, err := renderer.CreateTexture(sdl.PIXELFORMAT_RGBA8888, sdl.TEXTUREACCESS_TARGET, w, h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create render target: %w", err)
+	}
+	texture.SetBlendMode(sdl.BLENDMODE_BLEND)
+
+	previousTarget := renderer.GetRenderTarget()
+	if err := renderer.SetRenderTarget(texture); err != nil {
+		texture.Destroy()
+		return nil, fmt.Errorf("failed to set render target: %w", err)
+	}
+
+	r, g, b, a, _ := renderer.GetDrawColor()
+	defer func() {
+		renderer.SetRenderTarget(previousTarget)
+		renderer.SetDrawColor(r, g, b, a)
+	}()
+
+	renderer.SetDrawColor(0, 0, 0, 0)
+	renderer.Clear()
+	fn(renderer)
+
+	return texture, nil
+}
+
 // DrawSmoothScrollbar renders a simple square scrollbar
 func DrawSmoothScrollbar(renderer *sdl.Renderer, x, y, width, height int32, color sdl.Color) {
 	if width <= 0 || height <= 0 {
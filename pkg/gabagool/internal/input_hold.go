@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+)
+
+// HoldEvent reports that a registered button has been held for its hold duration.
+type HoldEvent struct {
+	Button   constants.VirtualButton
+	Duration time.Duration
+}
+
+// registeredHold tracks hold detection for one button.
+type registeredHold struct {
+	duration  time.Duration
+	onHold    func()
+	held      bool
+	fired     bool
+	pressedAt time.Time
+	timer     *time.Timer
+}
+
+// RegisterHold calls onHold once when button has been held for duration without being released,
+// and queues a HoldEvent for ProcessHoldEvent. Registering a button again replaces its hold.
+func (ip *Processor) RegisterHold(button constants.VirtualButton, duration time.Duration, onHold func()) {
+	ip.UnregisterHold(button)
+	ip.holds[button] = &registeredHold{duration: duration, onHold: onHold}
+}
+
+// PushHold registers a hold like RegisterHold and returns a function that puts back
+// whatever hold button had before, for components that only need the hold while they run.
+func (ip *Processor) PushHold(button constants.VirtualButton, duration time.Duration, onHold func()) (restore func()) {
+	previous, hadPrevious := ip.holds[button]
+	ip.RegisterHold(button, duration, onHold)
+
+	return func() {
+		ip.UnregisterHold(button)
+		if hadPrevious {
+			ip.RegisterHold(button, previous.duration, previous.onHold)
+		}
+	}
+}
+
+// UnregisterHold removes hold detection from button.
+func (ip *Processor) UnregisterHold(button constants.VirtualButton) {
+	hold, ok := ip.holds[button]
+	if !ok {
+		return
+	}
+
+	if hold.timer != nil {
+		hold.timer.Stop()
+	}
+	delete(ip.holds, button)
+}
+
+// ProcessHoldEvent returns the next queued hold event, if any
+func (ip *Processor) ProcessHoldEvent() *HoldEvent {
+	if len(ip.holdEventQueue) > 0 {
+		evt := ip.holdEventQueue[0]
+		ip.holdEventQueue = ip.holdEventQueue[1:]
+		return evt
+	}
+	return nil
+}
+
+// updateHold starts timing a registered button when it goes down and stops when it comes back up.
+// Key repeat presses while the button is already down don't restart the timer.
+func (ip *Processor) updateHold(button constants.VirtualButton, pressed bool, now time.Time) {
+	hold, ok := ip.holds[button]
+	if !ok {
+		return
+	}
+
+	if pressed && !hold.held {
+		hold.held = true
+		hold.fired = false
+		hold.pressedAt = now
+		// Wake the event loop when the hold is due, as nothing else may arrive while the button is held
+		hold.timer = time.AfterFunc(hold.duration, pushWakeEvent)
+	} else if !pressed && hold.held {
+		hold.held = false
+		if hold.timer != nil {
+			hold.timer.Stop()
+		}
+	}
+}
+
+// checkHolds fires any holds that have reached their duration.
+func (ip *Processor) checkHolds(now time.Time) {
+	for button, hold := range ip.holds {
+		if !hold.held || hold.fired || now.Sub(hold.pressedAt) < hold.duration {
+			continue
+		}
+
+		hold.fired = true
+		ip.holdEventQueue = append(ip.holdEventQueue, &HoldEvent{Button: button, Duration: hold.duration})
+		if hold.onHold != nil {
+			hold.onHold()
+		}
+	}
+}
@@ -27,6 +27,9 @@ const (
 	SourceJoystickAxisPositive
 	SourceJoystickAxisNegative
 	SourceHatSwitch
+	SourceInjected
+	SourceTurbo // repeats synthesized while a turbo button is held
+	SourceMouse
 )
 
 type Event struct {
@@ -34,6 +37,9 @@ type Event struct {
 	Pressed bool
 	Source  Source
 	RawCode int
+
+	// AxisValue is how far the stick was pushed for axis events, from -1 to 1. See JoystickAxisMapping.Scale.
+	AxisValue float32
 }
 
 // ComboType distinguishes between chord and sequence combinations
@@ -69,10 +75,44 @@ type SequenceOptions struct {
 	OnTrigger ComboCallback // Called when the sequence is completed
 }
 
+// AxisCurve shapes how stick travel between DeadZone and Threshold maps to an axis value.
+type AxisCurve int
+
+const (
+	CurveLinear AxisCurve = iota
+	CurveSquare           // finer control just outside the dead zone
+)
+
 type JoystickAxisMapping struct {
 	PositiveButton constants.VirtualButton
 	NegativeButton constants.VirtualButton
-	Threshold      int16
+	DeadZone       int16 // travel ignored around the center
+	Threshold      int16 // travel that presses the button and counts as fully pushed
+	Curve          AxisCurve
+}
+
+// Scale maps a raw axis value to -1..1. It is 0 inside DeadZone, ±1 from Threshold outwards and follows Curve in between.
+func (m JoystickAxisMapping) Scale(value int16) float32 {
+	magnitude := float32(value)
+	sign := float32(1)
+	if magnitude < 0 {
+		magnitude = -magnitude
+		sign = -1
+	}
+
+	deadZone, threshold := float32(m.DeadZone), float32(m.Threshold)
+	switch {
+	case magnitude <= deadZone:
+		return 0
+	case magnitude >= threshold:
+		return sign
+	}
+
+	scaled := (magnitude - deadZone) / (threshold - deadZone)
+	if m.Curve == CurveSquare {
+		scaled *= scaled
+	}
+	return sign * scaled
 }
 
 type InputMapping struct {
@@ -87,6 +127,9 @@ type InputMapping struct {
 	JoystickButtonMap map[uint8]constants.VirtualButton
 
 	JoystickHatMap map[uint8]constants.VirtualButton
+
+	// MouseMap maps mouse buttons (sdl.BUTTON_LEFT, sdl.BUTTON_MIDDLE, sdl.BUTTON_RIGHT) for use during development
+	MouseMap map[uint8]constants.VirtualButton
 }
 
 type Mapping struct {
@@ -99,12 +142,16 @@ type Mapping struct {
 	JoystickAxisMap map[int]struct {
 		PositiveButton int   `json:"positive_button"`
 		NegativeButton int   `json:"negative_button"`
+		DeadZone       int16 `json:"dead_zone"`
 		Threshold      int16 `json:"threshold"`
+		Curve          int   `json:"curve"`
 	} `json:"joystick_axis_map"`
 
 	JoystickButtonMap map[int]int `json:"joystick_button_map"`
 
 	JoystickHatMap map[int]int `json:"joystick_hat_map"`
+
+	MouseMap map[int]int `json:"mouse_map"`
 }
 
 func DefaultInputMapping() *InputMapping {
@@ -140,6 +187,13 @@ func DefaultInputMapping() *InputMapping {
 			sdl.CONTROLLER_BUTTON_START:         constants.VirtualButtonStart,
 			sdl.CONTROLLER_BUTTON_BACK:          constants.VirtualButtonSelect,
 			sdl.CONTROLLER_BUTTON_GUIDE:         constants.VirtualButtonMenu,
+			sdl.CONTROLLER_BUTTON_LEFTSTICK:     constants.VirtualButtonL3,
+			sdl.CONTROLLER_BUTTON_RIGHTSTICK:    constants.VirtualButtonR3,
+		},
+		MouseMap: map[uint8]constants.VirtualButton{
+			sdl.BUTTON_LEFT:   constants.VirtualButtonA,
+			sdl.BUTTON_RIGHT:  constants.VirtualButtonB,
+			sdl.BUTTON_MIDDLE: constants.VirtualButtonMenu,
 		},
 	}
 }
@@ -192,6 +246,7 @@ func LoadInputMappingFromBytes(data []byte) (*InputMapping, error) {
 		JoystickAxisMap:     make(map[uint8]JoystickAxisMapping),
 		JoystickButtonMap:   make(map[uint8]constants.VirtualButton),
 		JoystickHatMap:      make(map[uint8]constants.VirtualButton),
+		MouseMap:            make(map[uint8]constants.VirtualButton),
 	}
 
 	if serializableMapping.KeyboardMap != nil {
@@ -217,7 +272,9 @@ func LoadInputMappingFromBytes(data []byte) (*InputMapping, error) {
 			mapping.JoystickAxisMap[uint8(axis)] = JoystickAxisMapping{
 				PositiveButton: constants.VirtualButton(axisMapping.PositiveButton),
 				NegativeButton: constants.VirtualButton(axisMapping.NegativeButton),
+				DeadZone:       axisMapping.DeadZone,
 				Threshold:      axisMapping.Threshold,
+				Curve:          AxisCurve(axisMapping.Curve),
 			}
 		}
 	}
@@ -234,6 +291,12 @@ func LoadInputMappingFromBytes(data []byte) (*InputMapping, error) {
 		}
 	}
 
+	if serializableMapping.MouseMap != nil {
+		for mouseButton, button := range serializableMapping.MouseMap {
+			mapping.MouseMap[uint8(mouseButton)] = constants.VirtualButton(button)
+		}
+	}
+
 	return mapping, nil
 }
 
@@ -247,10 +310,13 @@ func (im *InputMapping) ToJSON() ([]byte, error) {
 		JoystickAxisMap: make(map[int]struct {
 			PositiveButton int   `json:"positive_button"`
 			NegativeButton int   `json:"negative_button"`
+			DeadZone       int16 `json:"dead_zone"`
 			Threshold      int16 `json:"threshold"`
+			Curve          int   `json:"curve"`
 		}),
 		JoystickButtonMap: make(map[int]int),
 		JoystickHatMap:    make(map[int]int),
+		MouseMap:          make(map[int]int),
 	}
 
 	for keyCode, button := range im.KeyboardMap {
@@ -269,11 +335,15 @@ func (im *InputMapping) ToJSON() ([]byte, error) {
 		serializableMapping.JoystickAxisMap[int(axis)] = struct {
 			PositiveButton int   `json:"positive_button"`
 			NegativeButton int   `json:"negative_button"`
+			DeadZone       int16 `json:"dead_zone"`
 			Threshold      int16 `json:"threshold"`
+			Curve          int   `json:"curve"`
 		}{
 			PositiveButton: int(axisMapping.PositiveButton),
 			NegativeButton: int(axisMapping.NegativeButton),
+			DeadZone:       axisMapping.DeadZone,
 			Threshold:      axisMapping.Threshold,
+			Curve:          int(axisMapping.Curve),
 		}
 	}
 
@@ -285,6 +355,10 @@ func (im *InputMapping) ToJSON() ([]byte, error) {
 		serializableMapping.JoystickHatMap[int(hat)] = int(button)
 	}
 
+	for mouseButton, button := range im.MouseMap {
+		serializableMapping.MouseMap[int(mouseButton)] = int(button)
+	}
+
 	return json.MarshalIndent(serializableMapping, "", "  ")
 }
 
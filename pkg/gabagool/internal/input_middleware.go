@@ -0,0 +1,35 @@
+package internal
+
+// middlewareEntry wraps an added middleware so its remove function can find it again.
+type middlewareEntry struct {
+	fn func(event *Event) *Event
+}
+
+// AddMiddleware runs fn on every event before ProcessSDLEvent returns it, after any middleware added earlier.
+// fn may return the event as is, change it, return a different event, or return nil to drop it.
+// Uses include logging all input, ignoring input during a transition or remapping buttons for one screen.
+// The returned function removes exactly this middleware; calling it again does nothing.
+func (ip *Processor) AddMiddleware(fn func(event *Event) *Event) (remove func()) {
+	entry := &middlewareEntry{fn: fn}
+	ip.middleware = append(ip.middleware, entry)
+
+	return func() {
+		for i, added := range ip.middleware {
+			if added == entry {
+				ip.middleware = append(ip.middleware[:i], ip.middleware[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// applyMiddleware passes evt through each middleware in order, stopping if one drops it.
+func (ip *Processor) applyMiddleware(evt *Event) *Event {
+	for _, entry := range ip.middleware {
+		if evt == nil {
+			return nil
+		}
+		evt = entry.fn(evt)
+	}
+	return evt
+}
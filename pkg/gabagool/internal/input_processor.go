@@ -59,6 +59,13 @@ func InitInputProcessor() {
 	)
 }
 
+var activationCooldown time.Duration
+
+// SetActivationCooldown sets how long presses are ignored after a component exits. 0 disables the cooldown.
+func SetActivationCooldown(d time.Duration) {
+	activationCooldown = d
+}
+
 func GetInputProcessor() *Processor {
 	return globalInputProcessor
 }
@@ -66,15 +73,38 @@ func GetInputProcessor() *Processor {
 type Processor struct {
 	mapping                       *InputMapping
 	gameControllerJoystickIndices map[int]bool
-	axisStates                    map[uint8]int8  // tracks which direction each axis is pressed: -1 (negative), 0 (none), 1 (positive)
-	hatStates                     map[uint8]uint8 // tracks the current hat position
-	eventQueue                    []*Event        // queue for events that need to be processed
+	axisStates                    map[uint8]int8    // tracks which direction each axis is pressed: -1 (negative), 0 (none), 1 (positive)
+	axisValues                    map[uint8]float32 // latest scaled value of each axis, see JoystickAxisMapping.Scale
+	hatStates                     map[uint8]uint8   // tracks the current hat position
+	eventQueue                    []*Event          // queue for events that need to be processed
+	middleware                    []*middlewareEntry
 
 	// Combo detection state
 	buttonStates     map[constants.VirtualButton]buttonState // tracks press times for each button
 	registeredCombos []registeredCombo                       // all registered combos
 	comboEventQueue  []*ComboEvent                           // queue for combo events
+	comboEvents      chan *ComboEvent                        // the same events, for callers that select on a channel
 	sequenceBuffer   []sequenceEntry                         // recent button presses for sequence detection
+
+	cooldownUntil time.Time // presses before this time are dropped so a stale press can't carry into the next component
+
+	options ProcessorOptions
+
+	turboButtons map[constants.VirtualButton]*turboState
+
+	holds          map[constants.VirtualButton]*registeredHold
+	holdEventQueue []*HoldEvent
+
+	recording      *InputRecording
+	recordingStart time.Time
+
+	mouseX, mouseY int32 // last cursor position seen in a mouse motion event
+}
+
+// ProcessorOptions holds hooks for events that aren't button input.
+type ProcessorOptions struct {
+	// OnControllerChanged is called with the number of open game controllers after one is connected or disconnected.
+	OnControllerChanged func(count int)
 }
 
 // buttonState tracks when a button was pressed
@@ -104,9 +134,13 @@ func NewInputProcessor() *Processor {
 		mapping:                       GetInputMapping(),
 		gameControllerJoystickIndices: make(map[int]bool),
 		axisStates:                    make(map[uint8]int8),
+		axisValues:                    make(map[uint8]float32),
 		hatStates:                     make(map[uint8]uint8),
 		buttonStates:                  make(map[constants.VirtualButton]buttonState),
 		registeredCombos:              make([]registeredCombo, 0),
+		comboEvents:                   make(chan *ComboEvent, comboEventBufferSize),
+		turboButtons:                  make(map[constants.VirtualButton]*turboState),
+		holds:                         make(map[constants.VirtualButton]*registeredHold),
 	}
 }
 
@@ -118,6 +152,66 @@ func (ip *Processor) IsGameControllerJoystick(joystickIndex int) bool {
 	return ip.gameControllerJoystickIndices[joystickIndex]
 }
 
+func (ip *Processor) SetOptions(opts ProcessorOptions) {
+	ip.options = opts
+}
+
+// handleControllerDevice opens newly connected game controllers and closes disconnected ones.
+func (ip *Processor) handleControllerDevice(e *sdl.ControllerDeviceEvent) {
+	logger := GetInternalLogger()
+
+	switch e.Type {
+	case sdl.CONTROLLERDEVICEADDED:
+		// For added devices Which is the joystick index
+		controller := sdl.GameControllerOpen(int(e.Which))
+		if controller == nil {
+			logger.Error("Failed to open connected game controller", "index", e.Which)
+			return
+		}
+
+		// SDL also reports controllers that were already connected at startup
+		instanceID := controller.Joystick().InstanceID()
+		for _, existing := range gameControllers {
+			if existing.Joystick().InstanceID() == instanceID {
+				controller.Close()
+				return
+			}
+		}
+
+		gameControllers = append(gameControllers, controller)
+		logger.Debug("Game controller connected", "index", e.Which, "name", controller.Name())
+	case sdl.CONTROLLERDEVICEREMOVED:
+		// For removed devices Which is the instance ID
+		found := false
+		for i, controller := range gameControllers {
+			if controller.Joystick().InstanceID() == sdl.JoystickID(e.Which) {
+				logger.Debug("Game controller disconnected", "name", controller.Name())
+				controller.Close()
+				gameControllers = append(gameControllers[:i], gameControllers[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return
+		}
+	default:
+		return
+	}
+
+	// Joystick indices shift when devices come and go, so register them again
+	ip.gameControllerJoystickIndices = make(map[int]bool)
+	for i := 0; i < sdl.NumJoysticks(); i++ {
+		if sdl.IsGameController(i) {
+			ip.RegisterGameControllerJoystickIndex(i)
+		}
+	}
+
+	if ip.options.OnControllerChanged != nil {
+		ip.options.OnControllerChanged(len(gameControllers))
+	}
+}
+
 // RegisterChord registers a chord combination (multiple buttons pressed simultaneously)
 func (ip *Processor) RegisterChord(id string, buttons []constants.VirtualButton, opts ChordOptions) error {
 	if len(buttons) < 2 {
@@ -181,7 +275,64 @@ func (ip *Processor) createEvent(button constants.VirtualButton, pressed bool, s
 	}
 }
 
+// MousePosition returns the last known cursor position, in window coordinates.
+func (ip *Processor) MousePosition() (x, y int32) {
+	return ip.mouseX, ip.mouseY
+}
+
+// createAxisEvent creates an Event for a stick crossing its threshold, carrying the scaled axis value.
+func (ip *Processor) createAxisEvent(button constants.VirtualButton, pressed bool, source Source, axis uint8, value float32) *Event {
+	evt := ip.createEvent(button, pressed, source, int(axis))
+	evt.AxisValue = value
+	return evt
+}
+
+// AxisValue returns how far the stick mapped to button is pushed towards it, from 0 to 1.
+// Components can poll this while the button is held, for example to scroll faster the further the stick is pushed.
+func (ip *Processor) AxisValue(button constants.VirtualButton) float32 {
+	for axis, mapping := range ip.mapping.JoystickAxisMap {
+		value := ip.axisValues[axis]
+		if mapping.PositiveButton == button && value > 0 {
+			return value
+		}
+		if mapping.NegativeButton == button && value < 0 {
+			return -value
+		}
+	}
+	return 0
+}
+
+// StartCooldown drops presses for the configured activation cooldown and discards queued events.
+// Components call this as they exit so the press that closed them can't also activate the next screen.
+// Queued and injected input the closing component didn't handle is always dropped.
+func (ip *Processor) StartCooldown() {
+	flushInjectedEvents()
+	ip.eventQueue = nil
+
+	if activationCooldown <= 0 {
+		return
+	}
+	ip.cooldownUntil = time.Now().Add(activationCooldown)
+}
+
 func (ip *Processor) ProcessSDLEvent(event sdl.Event) *Event {
+	evt := ip.applyMiddleware(ip.processSDLEvent(event))
+	if evt != nil && evt.Pressed && time.Now().Before(ip.cooldownUntil) {
+		GetInternalLogger().Debug("Press dropped during activation cooldown", "virtualButton", evt.Button.GetName())
+		return nil
+	}
+	if evt != nil && ip.recording != nil {
+		ip.recordEvent(evt)
+	}
+	if evt != nil && evt.Pressed {
+		DebugOverlay.recordButton(evt.Button)
+	}
+	return evt
+}
+
+func (ip *Processor) processSDLEvent(event sdl.Event) *Event {
+	ip.checkHolds(time.Now())
+
 	// If there are queued events, return those first
 	if len(ip.eventQueue) > 0 {
 		evt := ip.eventQueue[0]
@@ -192,6 +343,14 @@ func (ip *Processor) ProcessSDLEvent(event sdl.Event) *Event {
 	logger := GetInternalLogger()
 
 	switch e := event.(type) {
+	case *sdl.UserEvent:
+		if e.Type != inputEventType() {
+			return nil
+		}
+		if e.Code != wakeEventCode {
+			return ip.createEvent(constants.VirtualButton(e.Code>>1), e.Code&1 == 1, SourceInjected, 0)
+		}
+		return ip.nextTurboEvent(time.Now())
 	case *sdl.KeyboardEvent:
 		keyCode := e.Keysym.Sym
 		keyName := sdl.GetKeyName(keyCode)
@@ -280,6 +439,9 @@ func (ip *Processor) ProcessSDLEvent(event sdl.Event) *Event {
 	case *sdl.ControllerAxisEvent:
 		axisName := sdl.GameControllerGetStringForAxis(sdl.GameControllerAxis(e.Axis))
 		if axisConfig, exists := ip.mapping.JoystickAxisMap[e.Axis]; exists {
+			axisValue := axisConfig.Scale(e.Value)
+			ip.axisValues[e.Axis] = axisValue
+
 			previousState := ip.axisStates[e.Axis]
 			var newState int8 = 0
 
@@ -300,13 +462,13 @@ func (ip *Processor) ProcessSDLEvent(event sdl.Event) *Event {
 						"axis_code", fmt.Sprintf("%s+ (%d)", axisName, e.Axis),
 						"value", e.Value,
 						"virtual_button", axisConfig.PositiveButton.GetName())
-					return ip.createEvent(axisConfig.PositiveButton, false, SourceController, int(e.Axis))
+					return ip.createAxisEvent(axisConfig.PositiveButton, false, SourceController, e.Axis, axisValue)
 				} else if previousState == -1 {
 					logger.Debug("Controller axis negative released",
 						"axis_code", fmt.Sprintf("%s- (%d)", axisName, e.Axis),
 						"value", e.Value,
 						"virtual_button", axisConfig.NegativeButton.GetName())
-					return ip.createEvent(axisConfig.NegativeButton, false, SourceController, int(e.Axis))
+					return ip.createAxisEvent(axisConfig.NegativeButton, false, SourceController, e.Axis, axisValue)
 				}
 
 				// Generate press event for new state
@@ -316,20 +478,35 @@ func (ip *Processor) ProcessSDLEvent(event sdl.Event) *Event {
 						"value", e.Value,
 						"threshold", axisConfig.Threshold,
 						"virtual_button", axisConfig.PositiveButton.GetName())
-					return ip.createEvent(axisConfig.PositiveButton, true, SourceController, int(e.Axis))
+					return ip.createAxisEvent(axisConfig.PositiveButton, true, SourceController, e.Axis, axisValue)
 				} else if newState == -1 {
 					logger.Debug("Controller axis negative threshold exceeded",
 						"axis_code", fmt.Sprintf("%s- (%d)", axisName, e.Axis),
 						"value", e.Value,
 						"threshold", axisConfig.Threshold,
 						"virtual_button", axisConfig.NegativeButton.GetName())
-					return ip.createEvent(axisConfig.NegativeButton, true, SourceController, int(e.Axis))
+					return ip.createAxisEvent(axisConfig.NegativeButton, true, SourceController, e.Axis, axisValue)
 				}
 			}
 		}
 		logger.Debug("Controller axis not mapped or threshold not exceeded",
 			"axis_code", fmt.Sprintf("%s (%d)", axisName, e.Axis),
 			"value", e.Value)
+	case *sdl.ControllerDeviceEvent:
+		ip.handleControllerDevice(e)
+	case *sdl.MouseMotionEvent:
+		ip.mouseX, ip.mouseY = e.X, e.Y
+	case *sdl.MouseButtonEvent:
+		ip.mouseX, ip.mouseY = e.X, e.Y
+		if button, exists := ip.mapping.MouseMap[e.Button]; exists {
+			if e.Type == sdl.MOUSEBUTTONDOWN {
+				logger.Debug("Mouse button mapped",
+					"button_code", e.Button,
+					"virtualButton", button.GetName())
+			}
+			return ip.createEvent(button, e.Type == sdl.MOUSEBUTTONDOWN, SourceMouse, int(e.Button))
+		}
+		logger.Debug("Mouse button not mapped", "button_code", e.Button)
 	case *sdl.JoyButtonEvent:
 		joyButtonName := getJoyButtonName(e.Button)
 		if button, exists := ip.mapping.JoystickButtonMap[e.Button]; exists {
@@ -343,6 +520,9 @@ func (ip *Processor) ProcessSDLEvent(event sdl.Event) *Event {
 	case *sdl.JoyAxisEvent:
 		joyAxisName := getJoyAxisName(e.Axis)
 		if axisConfig, exists := ip.mapping.JoystickAxisMap[e.Axis]; exists {
+			axisValue := axisConfig.Scale(e.Value)
+			ip.axisValues[e.Axis] = axisValue
+
 			previousState := ip.axisStates[e.Axis]
 			var newState int8 = 0
 
@@ -363,13 +543,13 @@ func (ip *Processor) ProcessSDLEvent(event sdl.Event) *Event {
 						"axis_code", fmt.Sprintf("%s+ (%d)", joyAxisName, e.Axis),
 						"value", e.Value,
 						"virtual_button", axisConfig.PositiveButton.GetName())
-					return ip.createEvent(axisConfig.PositiveButton, false, SourceJoystick, int(e.Axis))
+					return ip.createAxisEvent(axisConfig.PositiveButton, false, SourceJoystick, e.Axis, axisValue)
 				} else if previousState == -1 {
 					logger.Debug("Joy axis negative released",
 						"axis_code", fmt.Sprintf("%s- (%d)", joyAxisName, e.Axis),
 						"value", e.Value,
 						"virtual_button", axisConfig.NegativeButton.GetName())
-					return ip.createEvent(axisConfig.NegativeButton, false, SourceJoystick, int(e.Axis))
+					return ip.createAxisEvent(axisConfig.NegativeButton, false, SourceJoystick, e.Axis, axisValue)
 				}
 
 				// Generate press event for new state
@@ -379,14 +559,14 @@ func (ip *Processor) ProcessSDLEvent(event sdl.Event) *Event {
 						"value", e.Value,
 						"threshold", axisConfig.Threshold,
 						"virtual_button", axisConfig.PositiveButton.GetName())
-					return ip.createEvent(axisConfig.PositiveButton, true, SourceJoystick, int(e.Axis))
+					return ip.createAxisEvent(axisConfig.PositiveButton, true, SourceJoystick, e.Axis, axisValue)
 				} else if newState == -1 {
 					logger.Debug("Joy axis negative threshold exceeded",
 						"axis_code", fmt.Sprintf("%s- (%d)", joyAxisName, e.Axis),
 						"value", e.Value,
 						"threshold", axisConfig.Threshold,
 						"virtual_button", axisConfig.NegativeButton.GetName())
-					return ip.createEvent(axisConfig.NegativeButton, true, SourceJoystick, int(e.Axis))
+					return ip.createAxisEvent(axisConfig.NegativeButton, true, SourceJoystick, e.Axis, axisValue)
 				}
 			}
 		}
@@ -451,6 +631,26 @@ func (ip *Processor) ProcessComboEvent() *ComboEvent {
 	return nil
 }
 
+// comboEventBufferSize is how many combo events ComboEvents holds before further events are dropped from it
+const comboEventBufferSize = 32
+
+// ComboEvents returns a channel that receives every combo event, so callers can select on it alongside other channels.
+// It is fed independently of ProcessComboEvent; use one or the other. Events are dropped from the channel while it is full.
+func (ip *Processor) ComboEvents() <-chan *ComboEvent {
+	return ip.comboEvents
+}
+
+// emitComboEvent queues a combo event for ProcessComboEvent and sends it to ComboEvents without blocking.
+func (ip *Processor) emitComboEvent(evt *ComboEvent) {
+	ip.comboEventQueue = append(ip.comboEventQueue, evt)
+
+	select {
+	case ip.comboEvents <- evt:
+	default:
+		GetInternalLogger().Debug("Combo event channel full, dropping event", "combo", evt.ComboID)
+	}
+}
+
 // updateButtonState updates tracking for a button and triggers combo checks
 func (ip *Processor) updateButtonState(button constants.VirtualButton, pressed bool) {
 	now := time.Now()
@@ -459,6 +659,8 @@ func (ip *Processor) updateButtonState(button constants.VirtualButton, pressed b
 		Pressed:   pressed,
 		PressTime: now,
 	}
+	ip.updateTurbo(button, pressed, now)
+	ip.updateHold(button, pressed, now)
 
 	if pressed {
 		ip.addToSequenceBuffer(button, now)
@@ -510,7 +712,7 @@ func (ip *Processor) checkChords(now time.Time) {
 
 		if allPressed && latestPress.Sub(earliestPress) <= combo.Chord.Window {
 			combo.active = true
-			ip.comboEventQueue = append(ip.comboEventQueue, &ComboEvent{
+			ip.emitComboEvent(&ComboEvent{
 				ComboID:   combo.ID,
 				ComboType: ComboTypeChord,
 				Buttons:   combo.Buttons,
@@ -536,7 +738,7 @@ func (ip *Processor) checkChordReleases() {
 			state, exists := ip.buttonStates[btn]
 			if !exists || !state.Pressed {
 				combo.active = false
-				ip.comboEventQueue = append(ip.comboEventQueue, &ComboEvent{
+				ip.emitComboEvent(&ComboEvent{
 					ComboID:   combo.ID,
 					ComboType: ComboTypeChord,
 					Buttons:   combo.Buttons,
@@ -561,7 +763,7 @@ func (ip *Processor) checkSequences(now time.Time) {
 		}
 
 		if ip.matchesSequence(combo, now) {
-			ip.comboEventQueue = append(ip.comboEventQueue, &ComboEvent{
+			ip.emitComboEvent(&ComboEvent{
 				ComboID:   combo.ID,
 				ComboType: ComboTypeSequence,
 				Buttons:   combo.Buttons,
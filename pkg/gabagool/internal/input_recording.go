@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+)
+
+// TimestampedEvent is a button event and when it happened, relative to the start of its recording.
+type TimestampedEvent struct {
+	Button    constants.VirtualButton
+	Pressed   bool
+	Timestamp time.Duration
+}
+
+// InputRecording is a sequence of button events captured by StartRecording and StopRecording.
+type InputRecording struct {
+	Events []TimestampedEvent
+}
+
+// StartRecording begins capturing the button events that components receive.
+// A recording already in progress is discarded and returned.
+func (ip *Processor) StartRecording() InputRecording {
+	previous := ip.StopRecording()
+
+	ip.recording = &InputRecording{}
+	ip.recordingStart = time.Now()
+
+	return previous
+}
+
+// StopRecording ends the current recording and returns it. It returns an empty recording if none was in progress.
+func (ip *Processor) StopRecording() InputRecording {
+	if ip.recording == nil {
+		return InputRecording{}
+	}
+
+	recording := *ip.recording
+	ip.recording = nil
+	return recording
+}
+
+func (ip *Processor) recordEvent(evt *Event) {
+	ip.recording.Events = append(ip.recording.Events, TimestampedEvent{
+		Button:    evt.Button,
+		Pressed:   evt.Pressed,
+		Timestamp: time.Since(ip.recordingStart),
+	})
+}
+
+// Playback replays a recording as if its buttons were pressed again.
+// In realtime the events keep their original spacing and are injected from a background goroutine, so Playback
+// returns straight away. Otherwise they are all injected at once, behind any input already waiting.
+// Either way they arrive like InjectButton's, alongside real input rather than in place of it.
+func (ip *Processor) Playback(recording InputRecording, realtime bool) {
+	if !realtime {
+		for _, recorded := range recording.Events {
+			if err := InjectButton(recorded.Button, recorded.Pressed); err != nil {
+				GetInternalLogger().Error("Failed to play back input", "error", err)
+			}
+		}
+		return
+	}
+
+	events := append([]TimestampedEvent(nil), recording.Events...)
+	go func() {
+		start := time.Now()
+		for _, recorded := range events {
+			if wait := recorded.Timestamp - time.Since(start); wait > 0 {
+				time.Sleep(wait)
+			}
+			if err := InjectButton(recorded.Button, recorded.Pressed); err != nil {
+				GetInternalLogger().Error("Failed to play back input", "error", err)
+			}
+		}
+	}()
+}
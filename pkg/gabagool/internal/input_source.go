@@ -0,0 +1,187 @@
+package internal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// InputSourceEnvVar enables scripted input. Set it to "stdin", the path of a named pipe, or the path of a script file
+// to run once.
+const InputSourceEnvVar = "GABAGOOL_INPUT"
+
+// inputTapDuration is how long a scripted tap holds the button before releasing it.
+const inputTapDuration = 50 * time.Millisecond
+
+// wakeEventCode marks an input event that only wakes the component's event loop, so it checks for queued,
+// turbo and hold events. Other codes carry an injected button, see injectedEventCode.
+const wakeEventCode = -1
+
+var (
+	inputEventTypeOnce sync.Once
+	inputEventTypeID   uint32
+)
+
+// inputEventType returns the SDL user event type that carries injected buttons and wake-ups to the processor.
+func inputEventType() uint32 {
+	inputEventTypeOnce.Do(func() {
+		inputEventTypeID = sdl.RegisterEvents(1)
+	})
+	return inputEventTypeID
+}
+
+// injectedEventCode packs a button and whether it was pressed into a user event code.
+func injectedEventCode(button constants.VirtualButton, pressed bool) int32 {
+	code := int32(button) << 1
+	if pressed {
+		code |= 1
+	}
+	return code
+}
+
+// InjectButton queues a virtual button press or release as if it came from a physical device.
+// Safe to call from any goroutine. It never blocks; an error is returned if SDL's event queue is full.
+// Injected input still queued when a component closes is dropped, see Processor.StartCooldown.
+func InjectButton(button constants.VirtualButton, pressed bool) error {
+	event := &sdl.UserEvent{Type: inputEventType(), Code: injectedEventCode(button, pressed)}
+	if _, err := sdl.PushEvent(event); err != nil {
+		return fmt.Errorf("failed to inject %s: %w", button.GetName(), err)
+	}
+	return nil
+}
+
+// pushWakeEvent wakes the component's event loop with a placeholder event, which the processor swaps for
+// a queued, turbo or hold event.
+func pushWakeEvent() {
+	sdl.PushEvent(&sdl.UserEvent{Type: inputEventType(), Code: wakeEventCode})
+}
+
+// flushInjectedEvents drops injected buttons and wake-ups that no component has handled yet.
+func flushInjectedEvents() {
+	sdl.FlushEvent(inputEventType())
+}
+
+// InjectButtonPress injects a press of button through InjectButton. Held buttons and combos are updated when a
+// component receives it, not before. With UseTestMode this lets tests drive components, e.g. press Down three times then A and check the selection.
+func (ip *Processor) InjectButtonPress(button constants.VirtualButton) error {
+	return ip.InjectInput(&Event{Button: button, Pressed: true})
+}
+
+// InjectButtonRelease injects a release of button, like InjectButtonPress.
+func (ip *Processor) InjectButtonRelease(button constants.VirtualButton) error {
+	return ip.InjectInput(&Event{Button: button, Pressed: false})
+}
+
+// StartInputSourceFromEnv starts reading scripted input if InputSourceEnvVar is set.
+func StartInputSourceFromEnv() {
+	source := os.Getenv(InputSourceEnvVar)
+	if source == "" {
+		return
+	}
+
+	if source == "stdin" {
+		go ReadInputScript(os.Stdin)
+		return
+	}
+
+	info, err := os.Stat(source)
+	if err != nil {
+		GetInternalLogger().Error("Failed to open input source", "path", source, "error", err)
+		return
+	}
+
+	go func() {
+		for {
+			file, err := os.Open(source)
+			if err != nil {
+				GetInternalLogger().Error("Failed to open input source", "path", source, "error", err)
+				return
+			}
+			ReadInputScript(file)
+			file.Close()
+
+			// Reopen a pipe after each writer closes it so several scripts can be fed in turn. A regular file
+			// would only read the same script again straight away.
+			if info.Mode()&os.ModeNamedPipe == 0 {
+				return
+			}
+		}
+	}()
+}
+
+// ReadInputScript reads one command per line and injects the matching button events until r is exhausted.
+//
+//	A          tap A (press, then release)
+//	+DOWN      press and hold Down
+//	-DOWN      release Down
+//	WAIT 500   pause for 500ms
+//
+// Button names are case-insensitive and match VirtualButton.GetName. Blank lines and lines starting with # are ignored.
+// Input still queued when a component closes is dropped, so WAIT for the next screen before driving it.
+func ReadInputScript(r io.Reader) {
+	logger := GetInternalLogger()
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(strings.ToUpper(line))
+		if fields[0] == "WAIT" && len(fields) == 2 {
+			if ms, err := strconv.Atoi(fields[1]); err == nil {
+				time.Sleep(time.Duration(ms) * time.Millisecond)
+				continue
+			}
+		}
+
+		name := fields[0]
+		action := byte(0)
+		if name[0] == '+' || name[0] == '-' {
+			action = name[0]
+			name = name[1:]
+		}
+
+		button, ok := parseButtonName(name)
+		if !ok {
+			logger.Error("Unknown scripted input", "line", line)
+			continue
+		}
+
+		logger.Debug("Scripted input", "button", button.GetName(), "action", string(action))
+
+		var err error
+		switch action {
+		case '+':
+			err = InjectButton(button, true)
+		case '-':
+			err = InjectButton(button, false)
+		default:
+			if err = InjectButton(button, true); err == nil {
+				time.Sleep(inputTapDuration)
+				err = InjectButton(button, false)
+			}
+		}
+		if err != nil {
+			logger.Error("Failed to inject scripted input", "line", line, "error", err)
+		}
+	}
+}
+
+func parseButtonName(name string) (constants.VirtualButton, bool) {
+	for button := constants.VirtualButtonUp; button <= constants.VirtualButtonR3; button++ {
+		if strings.EqualFold(button.GetName(), name) {
+			return button, true
+		}
+	}
+	return constants.VirtualButtonUnassigned, false
+}
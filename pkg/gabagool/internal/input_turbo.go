@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+)
+
+// turboState tracks auto-fire for one button.
+type turboState struct {
+	interval time.Duration
+	held     bool
+	lastFire time.Time
+	pressDue bool // a synthesized release went out and its press follows on the next wake-up
+	stop     chan struct{}
+}
+
+// RegisterTurbo makes button auto-fire while held, repeating a release and press rateHz times a second.
+// Registering a button again replaces its rate. A rate of 0 or less unregisters it.
+func (ip *Processor) RegisterTurbo(button constants.VirtualButton, rateHz int) {
+	ip.UnregisterTurbo(button)
+	if rateHz <= 0 {
+		return
+	}
+
+	ip.turboButtons[button] = &turboState{interval: time.Second / time.Duration(rateHz)}
+}
+
+// UnregisterTurbo stops auto-fire for button.
+func (ip *Processor) UnregisterTurbo(button constants.VirtualButton) {
+	turbo, ok := ip.turboButtons[button]
+	if !ok {
+		return
+	}
+
+	if turbo.held {
+		close(turbo.stop)
+	}
+	delete(ip.turboButtons, button)
+}
+
+// updateTurbo starts or stops auto-fire as a registered button is physically pressed or released.
+func (ip *Processor) updateTurbo(button constants.VirtualButton, pressed bool, now time.Time) {
+	turbo, ok := ip.turboButtons[button]
+	if !ok {
+		return
+	}
+
+	if pressed && !turbo.held {
+		turbo.held = true
+		turbo.lastFire = now
+		turbo.stop = make(chan struct{})
+		go wakeForTurbo(turbo.interval, turbo.stop)
+	} else if !pressed && turbo.held {
+		turbo.held = false
+		turbo.pressDue = false
+		close(turbo.stop)
+	}
+}
+
+// wakeForTurbo pushes a placeholder event at the turbo rate so components waiting on SDL events call
+// ProcessSDLEvent even though nothing physical is happening.
+func wakeForTurbo(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			pushWakeEvent()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// nextTurboEvent returns the synthesized event for a wake-up: the press following an earlier release, or a release
+// for the first held turbo button that is due to fire. The press is kept on the turbo state rather than queued,
+// so a physical release arriving in between still reaches updateTurbo and cancels it.
+// Synthesized events bypass updateButtonState so they don't end the hold or trip combos.
+func (ip *Processor) nextTurboEvent(now time.Time) *Event {
+	for button, turbo := range ip.turboButtons {
+		if turbo.held && turbo.pressDue {
+			turbo.pressDue = false
+			return &Event{Button: button, Pressed: true, Source: SourceTurbo}
+		}
+	}
+
+	for button, turbo := range ip.turboButtons {
+		if !turbo.held || now.Sub(turbo.lastFire) < turbo.interval {
+			continue
+		}
+		turbo.lastFire = now
+		turbo.pressDue = true
+		pushWakeEvent()
+
+		return &Event{Button: button, Pressed: false, Source: SourceTurbo}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// modalDimColor darkens the screen behind a modal enough for white dialog text to read over any content.
+var modalDimColor = sdl.Color{R: 0, G: 0, B: 0, A: 200}
+
+// ShowModal draws one frame of a dialog over another screen. renderBackground draws the screen underneath,
+// which is dimmed before renderModal draws the dialog on top. The caller presents the frame.
+func ShowModal(renderer *sdl.Renderer, renderBackground func(), renderModal func()) error {
+	renderBackground()
+
+	if err := renderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND); err != nil {
+		return fmt.Errorf("failed to set blend mode: %w", err)
+	}
+	renderer.SetDrawColor(modalDimColor.R, modalDimColor.G, modalDimColor.B, modalDimColor.A)
+	if err := renderer.FillRect(nil); err != nil {
+		return fmt.Errorf("failed to dim background: %w", err)
+	}
+
+	renderModal()
+	return nil
+}
@@ -0,0 +1,13 @@
+package internal
+
+var reducedMotion bool
+
+// SetReducedMotion turns off scroll animations and marquee text, so components snap straight to where
+// they are going. It also saves work on slow hardware.
+func SetReducedMotion(enabled bool) {
+	reducedMotion = enabled
+}
+
+func ReducedMotion() bool {
+	return reducedMotion
+}
@@ -15,3 +15,25 @@ func UniformPadding(value int32) Padding {
 		Left:   value,
 	}
 }
+
+var safeArea Padding
+
+// SetSafeArea sets the global inset that components add to their margins.
+func SetSafeArea(inset Padding) {
+	safeArea = inset
+}
+
+// GetSafeArea returns the global safe-area inset.
+func GetSafeArea() Padding {
+	return safeArea
+}
+
+// WithSafeArea returns the padding grown by the global safe-area inset.
+func (p Padding) WithSafeArea() Padding {
+	return Padding{
+		Top:    p.Top + safeArea.Top,
+		Right:  p.Right + safeArea.Right,
+		Bottom: p.Bottom + safeArea.Bottom,
+		Left:   p.Left + safeArea.Left,
+	}
+}
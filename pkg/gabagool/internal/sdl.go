@@ -23,6 +23,7 @@ func Init(title string, showBackground bool, pbc PowerButtonConfig) {
 	}
 
 	InitInputProcessor()
+	StartInputSourceFromEnv()
 
 	window = initWindow(title, showBackground)
 
@@ -34,6 +35,7 @@ func Init(title string, showBackground bool, pbc PowerButtonConfig) {
 }
 
 func SDLCleanup() {
+	Glyphs.Clear()
 	window.closeWindow()
 	CloseAllControllers()
 	closeFonts()
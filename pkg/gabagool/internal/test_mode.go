@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+// Components lay out as if on a 1024×768 screen in test mode, though nothing is drawn beyond one pixel.
+const (
+	testModeWidth  = 1024
+	testModeHeight = 768
+)
+
+// UseTestMode replaces the window with a headless one, so component logic such as input handling can run in
+// unit tests without a display. Its software renderer draws to a 1×1 surface. Call it instead of Init.
+// Only SDL's event subsystem is started, which components wait on and injected input is delivered through.
+func UseTestMode() error {
+	if err := sdl.Init(sdl.INIT_EVENTS); err != nil {
+		return fmt.Errorf("failed to initialize SDL events: %w", err)
+	}
+
+	if err := ttf.Init(); err != nil {
+		return fmt.Errorf("failed to initialize fonts: %w", err)
+	}
+
+	surface, err := sdl.CreateRGBSurfaceWithFormat(0, 1, 1, 32, sdl.PIXELFORMAT_RGBA8888)
+	if err != nil {
+		return fmt.Errorf("failed to create test surface: %w", err)
+	}
+
+	renderer, err := sdl.CreateSoftwareRenderer(surface)
+	if err != nil {
+		surface.Free()
+		return fmt.Errorf("failed to create test renderer: %w", err)
+	}
+
+	window = &Window{
+		Renderer:      renderer,
+		Title:         "test",
+		surface:       surface,
+		logicalWidth:  testModeWidth,
+		logicalHeight: testModeHeight,
+	}
+
+	globalInputProcessor = NewInputProcessor()
+	initFonts(DefaultFontSizes)
+
+	return nil
+}
+
+// InjectInput delivers evt's button through InjectButton, the same path scripted and played back input takes.
+// Only Button and Pressed are used; the rest of the event is filled in when it is delivered.
+func (ip *Processor) InjectInput(evt *Event) error {
+	return InjectButton(evt.Button, evt.Pressed)
+}
@@ -1,81 +1,153 @@
 package internal
 
-import "github.com/veandco/go-sdl2/sdl"
+import (
+	"container/list"
+	"sync/atomic"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
 
 const defaultMaxCacheSize = 5
 
+var (
+	// cacheByteLimit caps the estimated memory of all budgeted texture caches combined. 0 means no limit.
+	cacheByteLimit atomic.Int64
+	cacheBytesUsed atomic.Int64
+	cacheEntries   atomic.Int64
+)
+
+// SetTextureCacheLimit sets the combined byte budget for all image texture caches. 0 disables the limit.
+func SetTextureCacheLimit(bytes int64) {
+	if bytes < 0 {
+		bytes = 0
+	}
+	cacheByteLimit.Store(bytes)
+}
+
+// GetTextureCacheUsage returns the estimated bytes held by all image texture caches and the number of cached textures.
+func GetTextureCacheUsage() (bytes int64, entries int) {
+	return cacheBytesUsed.Load(), int(cacheEntries.Load())
+}
+
+// TextureCache holds textures up to maxSize entries, destroying the least recently used one to make room.
+// Entries live in a list ordered from least to most recently used, with a map for lookups.
 type TextureCache struct {
-	textures map[string]*sdl.Texture
-	order    []string // tracks insertion order for LRU eviction
+	entries  map[string]*list.Element
+	order    *list.List
 	maxSize  int
+	budgeted bool // counts against cacheByteLimit
+}
+
+type textureCacheEntry struct {
+	key     string
+	texture *sdl.Texture
+	size    int64
 }
 
 func NewTextureCache() *TextureCache {
 	return NewTextureCacheWithSize(defaultMaxCacheSize)
 }
 
+// NewTextureCacheWithSize creates a cache holding at most maxSize textures, sharing the byte budget set by
+// SetTextureCacheLimit with every other image cache.
 func NewTextureCacheWithSize(maxSize int) *TextureCache {
+	cache := newUnbudgetedTextureCache(maxSize)
+	cache.budgeted = true
+	return cache
+}
+
+// newUnbudgetedTextureCache creates a cache limited only by its entry count, for textures such as rendered text
+// that shouldn't compete with images for the byte budget.
+func newUnbudgetedTextureCache(maxSize int) *TextureCache {
+	if maxSize < 1 {
+		maxSize = 1
+	}
 	return &TextureCache{
-		textures: make(map[string]*sdl.Texture),
-		order:    make([]string, 0, maxSize),
-		maxSize:  maxSize,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		maxSize: maxSize,
 	}
 }
 
 func (c *TextureCache) Get(key string) *sdl.Texture {
-	if texture, exists := c.textures[key]; exists {
-		// Move to end (most recently used)
-		c.moveToEnd(key)
-		return texture
+	if element, exists := c.entries[key]; exists {
+		c.order.MoveToBack(element)
+		return element.Value.(*textureCacheEntry).texture
 	}
 	return nil
 }
 
 func (c *TextureCache) Set(key string, texture *sdl.Texture) {
-	// If key already exists, just update and move to end
-	if _, exists := c.textures[key]; exists {
-		c.textures[key] = texture
-		c.moveToEnd(key)
+	size := textureBytes(texture)
+
+	// If key already exists, replace its texture and mark it most recently used
+	if element, exists := c.entries[key]; exists {
+		entry := element.Value.(*textureCacheEntry)
+		if entry.texture != texture {
+			entry.texture.Destroy()
+		}
+		c.track(size-entry.size, 0)
+		entry.texture = texture
+		entry.size = size
+		c.order.MoveToBack(element)
 		return
 	}
 
 	// Evict oldest if at capacity
-	if len(c.order) >= c.maxSize {
+	if len(c.entries) >= c.maxSize {
 		c.evictOldest()
 	}
 
-	c.textures[key] = texture
-	c.order = append(c.order, key)
+	// Evict until the new texture fits in the byte budget, always keeping room for at least this one
+	if limit := cacheByteLimit.Load(); c.budgeted && limit > 0 {
+		for len(c.entries) > 0 && cacheBytesUsed.Load()+size > limit {
+			c.evictOldest()
+		}
+	}
+
+	c.entries[key] = c.order.PushBack(&textureCacheEntry{key: key, texture: texture, size: size})
+	c.track(size, 1)
 }
 
-func (c *TextureCache) moveToEnd(key string) {
-	for i, k := range c.order {
-		if k == key {
-			c.order = append(c.order[:i], c.order[i+1:]...)
-			c.order = append(c.order, key)
-			return
-		}
+// track adds to the usage reported by GetTextureCacheUsage when the cache counts against the byte budget.
+func (c *TextureCache) track(bytes, entries int64) {
+	if !c.budgeted {
+		return
 	}
+	cacheBytesUsed.Add(bytes)
+	cacheEntries.Add(entries)
 }
 
 func (c *TextureCache) evictOldest() {
-	if len(c.order) == 0 {
+	oldest := c.order.Front()
+	if oldest == nil {
 		return
 	}
+	c.remove(oldest)
+}
 
-	oldest := c.order[0]
-	c.order = c.order[1:]
+func (c *TextureCache) remove(element *list.Element) {
+	entry := c.order.Remove(element).(*textureCacheEntry)
+	delete(c.entries, entry.key)
 
-	if texture, exists := c.textures[oldest]; exists {
-		texture.Destroy()
-		delete(c.textures, oldest)
-	}
+	entry.texture.Destroy()
+	c.track(-entry.size, -1)
 }
 
 func (c *TextureCache) Destroy() {
-	for _, texture := range c.textures {
-		texture.Destroy()
+	for c.order.Len() > 0 {
+		c.remove(c.order.Front())
+	}
+}
+
+// textureBytes estimates a texture's memory footprint assuming 4 bytes per pixel.
+func textureBytes(texture *sdl.Texture) int64 {
+	if texture == nil {
+		return 0
+	}
+	_, _, w, h, err := texture.Query()
+	if err != nil {
+		return 0
 	}
-	c.textures = make(map[string]*sdl.Texture)
-	c.order = c.order[:0]
+	return int64(w) * int64(h) * 4
 }
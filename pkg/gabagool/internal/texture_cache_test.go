@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// newTestRenderer returns a software renderer, which needs no display, for creating textures to cache.
+func newTestRenderer(t *testing.T) *sdl.Renderer {
+	t.Helper()
+	surface, err := sdl.CreateRGBSurfaceWithFormat(0, 16, 16, 32, sdl.PIXELFORMAT_RGBA8888)
+	if err != nil {
+		t.Fatalf("CreateRGBSurfaceWithFormat: %v", err)
+	}
+	renderer, err := sdl.CreateSoftwareRenderer(surface)
+	if err != nil {
+		surface.Free()
+		t.Fatalf("CreateSoftwareRenderer: %v", err)
+	}
+	t.Cleanup(func() {
+		renderer.Destroy()
+		surface.Free()
+	})
+	return renderer
+}
+
+// newTestTexture returns a 4x4 texture, 64 bytes by textureBytes' estimate.
+func newTestTexture(t *testing.T, renderer *sdl.Renderer) *sdl.Texture {
+	t.Helper()
+	texture, err := renderer.CreateTexture(sdl.PIXELFORMAT_RGBA8888, sdl.TEXTUREACCESS_STATIC, 4, 4)
+	if err != nil {
+		t.Fatalf("CreateTexture: %v", err)
+	}
+	return texture
+}
+
+func TestTextureCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	renderer := newTestRenderer(t)
+	cache := NewTextureCacheWithSize(2)
+	defer cache.Destroy()
+
+	a, b, c := newTestTexture(t, renderer), newTestTexture(t, renderer), newTestTexture(t, renderer)
+	cache.Set("a", a)
+	cache.Set("b", b)
+
+	// Reading a makes b the least recently used
+	if cache.Get("a") != a {
+		t.Fatal("Get(a) did not return the cached texture")
+	}
+	cache.Set("c", c)
+
+	if cache.Get("b") != nil {
+		t.Error("b was not evicted")
+	}
+	if cache.Get("a") != a || cache.Get("c") != c {
+		t.Error("a and c should still be cached")
+	}
+}
+
+func TestTextureCacheReplacesExistingKey(t *testing.T) {
+	renderer := newTestRenderer(t)
+	cache := NewTextureCacheWithSize(2)
+	defer cache.Destroy()
+
+	bytesBefore, entriesBefore := GetTextureCacheUsage()
+
+	cache.Set("a", newTestTexture(t, renderer))
+	cache.Set("b", newTestTexture(t, renderer))
+	replacement := newTestTexture(t, renderer)
+	cache.Set("a", replacement)
+	cache.Set("c", newTestTexture(t, renderer))
+
+	// Replacing a refreshed it, so b was the one evicted
+	if cache.Get("a") != replacement || cache.Get("b") != nil {
+		t.Error("replacing a did not mark it most recently used")
+	}
+
+	bytes, entries := GetTextureCacheUsage()
+	if bytes-bytesBefore != 128 || entries-entriesBefore != 2 {
+		t.Errorf("usage grew by %d bytes and %d entries, want 128 and 2", bytes-bytesBefore, entries-entriesBefore)
+	}
+}
+
+func TestTextureCacheByteLimit(t *testing.T) {
+	renderer := newTestRenderer(t)
+	cache := NewTextureCacheWithSize(10)
+
+	bytesBefore, entriesBefore := GetTextureCacheUsage()
+	SetTextureCacheLimit(bytesBefore + 128)
+	defer SetTextureCacheLimit(0)
+
+	cache.Set("a", newTestTexture(t, renderer))
+	cache.Set("b", newTestTexture(t, renderer))
+	cache.Set("c", newTestTexture(t, renderer))
+
+	if cache.Get("a") != nil {
+		t.Error("a was not evicted to stay within the byte limit")
+	}
+	if cache.Get("b") == nil || cache.Get("c") == nil {
+		t.Error("b and c should still be cached")
+	}
+
+	cache.Destroy()
+
+	if bytes, entries := GetTextureCacheUsage(); bytes != bytesBefore || entries != entriesBefore {
+		t.Errorf("usage after Destroy = %d bytes, %d entries, want %d and %d", bytes, entries, bytesBefore, entriesBefore)
+	}
+}
@@ -12,16 +12,84 @@ type Theme struct {
 	HighlightedTextColor sdl.Color // Color5: Text on highlighted items
 	HintColor            sdl.Color // Color6: Help text, status bar text
 	BackgroundColor      sdl.Color // BGColor: Screen background
+	CardBackground       sdl.Color // Popup and panel backgrounds
+	ShadowColor          sdl.Color // Dims the screen behind popups
 	FontPath             string
 	BackgroundImagePath  string
 }
 
-var currentTheme Theme
+var (
+	currentTheme Theme
+	highContrast bool
 
+	// themeOverride is the theme of the component rendering right now, if it has its own. It sits on top of
+	// currentTheme rather than replacing it, so SetTheme during an override isn't undone when it ends.
+	themeOverride *Theme
+)
+
+// SetTheme makes theme current. Themes that leave CardBackground or ShadowColor fully transparent get the
+// dark defaults, so platform themes that predate those fields keep rendering as before.
 func SetTheme(theme Theme) {
-	currentTheme = theme
+	currentTheme = withThemeDefaults(theme)
+}
+
+// OverrideTheme makes GetTheme return theme until the returned function is called, which ends the override.
+// The theme set with SetTheme is left alone. A nil theme changes nothing. Components use it to render with a
+// theme of their own:
+//
+//	defer internal.OverrideTheme(options.ThemeOverride)()
+func OverrideTheme(theme *Theme) (restore func()) {
+	if theme == nil {
+		return func() {}
+	}
+
+	previous := themeOverride
+	override := withThemeDefaults(*theme)
+	themeOverride = &override
+	return func() {
+		themeOverride = previous
+	}
 }
 
+func withThemeDefaults(theme Theme) Theme {
+	if theme.CardBackground.A == 0 {
+		theme.CardBackground = sdl.Color{R: 30, G: 30, B: 30, A: 255}
+	}
+	if theme.ShadowColor.A == 0 {
+		theme.ShadowColor = sdl.Color{R: 0, G: 0, B: 0, A: 160}
+	}
+	return theme
+}
+
+// GetTheme returns the theme to draw with: the high-contrast theme while high-contrast mode is on,
+// otherwise the override of the component rendering, if any, or the current theme.
 func GetTheme() Theme {
+	if highContrast {
+		return HighContrastTheme()
+	}
+	if themeOverride != nil {
+		return *themeOverride
+	}
 	return currentTheme
 }
+
+// SetHighContrast forces HighContrastTheme on every component, including those with a theme override,
+// and hides background images.
+func SetHighContrast(enabled bool) {
+	highContrast = enabled
+}
+
+// HighContrastTheme has white text on pure black, with yellow and cyan marking anything interactive.
+func HighContrastTheme() Theme {
+	return Theme{
+		HighlightColor:       HexToColor(0xFFFF00),
+		AccentColor:          HexToColor(0x00FFFF),
+		ButtonLabelColor:     HexToColor(0x000000),
+		TextColor:            HexToColor(0xFFFFFF),
+		HighlightedTextColor: HexToColor(0x000000),
+		HintColor:            HexToColor(0xFFFFFF),
+		BackgroundColor:      HexToColor(0x000000),
+		CardBackground:       HexToColor(0x000000),
+		ShadowColor:          sdl.Color{R: 0, G: 0, B: 0, A: 220},
+	}
+}
@@ -1,11 +1,13 @@
 package internal
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"sync"
 
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+	"github.com/veandco/go-sdl2/gfx"
 	"github.com/veandco/go-sdl2/img"
 	"github.com/veandco/go-sdl2/sdl"
 )
@@ -20,6 +22,28 @@ type Window struct {
 	DisplayBackground bool
 	PowerButtonWG     sync.WaitGroup
 	PowerButtonConfig PowerButtonConfig
+
+	gradient *sdl.Texture
+
+	// logicalWidth and logicalHeight are set by SetLogicalSize; 0 means the window's own size.
+	logicalWidth  int32
+	logicalHeight int32
+
+	// surface is what the renderer draws to in test mode, where there is no SDL window
+	surface *sdl.Surface
+
+	// screenshots are requested from any goroutine and taken by the render loop in Present
+	screenshotMu sync.Mutex
+	screenshots  []screenshotRequest
+
+	// lastFrame keeps the pixels of the last presented frame for CaptureTexture, since the back buffer can't be
+	// read back once it has been presented
+	lastFrame *sdl.Surface
+}
+
+type screenshotRequest struct {
+	filename string
+	result   chan error
 }
 
 func initWindow(title string, displayBackground bool) *Window {
@@ -78,7 +102,12 @@ func initWindowWithSize(title string, width, height int32, displayBackground boo
 	var renderer *sdl.Renderer
 	var lastErr error
 
-	renderer, lastErr = sdl.CreateRenderer(window, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC|sdl.RENDERER_TARGETTEXTURE)
+	rendererFlags := uint32(sdl.RENDERER_ACCELERATED | sdl.RENDERER_TARGETTEXTURE)
+	if vsync {
+		rendererFlags |= sdl.RENDERER_PRESENTVSYNC
+	}
+
+	renderer, lastErr = sdl.CreateRenderer(window, -1, rendererFlags)
 
 	if lastErr != nil {
 		GetInternalLogger().Error("Failed to create any renderer!", "final_error", lastErr)
@@ -119,6 +148,17 @@ func (window *Window) loadBackground() {
 }
 
 func (window *Window) closeWindow() {
+	if window.lastFrame != nil {
+		window.lastFrame.Free()
+		window.lastFrame = nil
+	}
+
+	if window.surface != nil {
+		window.Renderer.Destroy()
+		window.surface.Free()
+		return
+	}
+
 	if !constants.IsDevMode() {
 		window.PowerButtonWG.Done()
 	}
@@ -126,6 +166,9 @@ func (window *Window) closeWindow() {
 	if window.Background != nil {
 		window.Background.Destroy()
 	}
+	if window.gradient != nil {
+		window.gradient.Destroy()
+	}
 	window.Renderer.Destroy()
 	window.Window.Destroy()
 
@@ -137,18 +180,220 @@ func GetWindow() *Window {
 }
 
 func (window *Window) GetWidth() int32 {
+	if window.logicalWidth > 0 {
+		return window.logicalWidth
+	}
 	w, _ := window.Window.GetSize()
 	return w
 }
 
 func (window *Window) GetHeight() int32 {
+	if window.logicalHeight > 0 {
+		return window.logicalHeight
+	}
 	_, h := window.Window.GetSize()
 	return h
 }
 
+// SetLogicalSize lays components out at a fixed w×h resolution, such as 640×480, which SDL scales to fill the
+// window. GetWidth and GetHeight return the logical size from then on, and mouse positions are scaled to match.
+// Call it after Init, between components.
+func (window *Window) SetLogicalSize(w, h int32) error {
+	if err := window.Renderer.SetLogicalSize(w, h); err != nil {
+		return fmt.Errorf("failed to set logical size: %w", err)
+	}
+
+	window.logicalWidth = w
+	window.logicalHeight = h
+
+	// Font sizes follow the screen width, so they are reloaded for the new one
+	reloadFonts()
+	return nil
+}
+
+// HasBackground reports whether RenderBackground has anything to draw.
+func (window *Window) HasBackground() bool {
+	if highContrast {
+		return false
+	}
+	return window.gradient != nil || window.Background != nil
+}
+
+// RenderBackground draws the gradient background if one is set, otherwise the theme's background image.
 func (window *Window) RenderBackground() {
-	if window.Background != nil {
-		window.Renderer.Copy(window.Background, nil, &sdl.Rect{X: 0, Y: 0, W: window.GetWidth(), H: window.GetHeight()})
+	if highContrast {
+		window.Renderer.SetDrawColor(0, 0, 0, 255)
+		window.Renderer.Clear()
+		return
+	}
+
+	background := window.Background
+	if window.gradient != nil {
+		background = window.gradient
+	}
+
+	if background != nil {
+		window.Renderer.Copy(background, nil, &sdl.Rect{X: 0, Y: 0, W: window.GetWidth(), H: window.GetHeight()})
+	}
+}
+
+// RequestScreenshot asks the render loop to save its next frame to a PNG file at filename.
+// It is safe to call from any goroutine. The returned channel receives the result once the frame is presented.
+func (window *Window) RequestScreenshot(filename string) <-chan error {
+	result := make(chan error, 1)
+
+	window.screenshotMu.Lock()
+	window.screenshots = append(window.screenshots, screenshotRequest{filename: filename, result: result})
+	window.screenshotMu.Unlock()
+
+	pushWakeEvent()
+	return result
+}
+
+// CancelScreenshot withdraws a request made by RequestScreenshot that no frame has taken yet.
+// It returns false if a frame already took it, in which case its result is still delivered.
+func (window *Window) CancelScreenshot(result <-chan error) bool {
+	window.screenshotMu.Lock()
+	defer window.screenshotMu.Unlock()
+
+	for i, request := range window.screenshots {
+		if request.result == result {
+			window.screenshots = append(window.screenshots[:i], window.screenshots[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ScreenshotPending reports whether a screenshot is waiting for the next frame, so loops that skip
+// unchanged frames know to draw one.
+func (window *Window) ScreenshotPending() bool {
+	window.screenshotMu.Lock()
+	defer window.screenshotMu.Unlock()
+	return len(window.screenshots) > 0
+}
+
+// Present shows the frame drawn on the renderer, saving it first for any pending RequestScreenshot and keeping
+// a copy for CaptureTexture. The back buffer is undefined once presented, so frames must be read here rather than after.
+func (window *Window) Present() {
+	window.screenshotMu.Lock()
+	requests := window.screenshots
+	window.screenshots = nil
+	window.screenshotMu.Unlock()
+
+	for _, request := range requests {
+		request.result <- window.CaptureScreenshot(request.filename)
+	}
+
+	window.retainFrame()
+	window.Renderer.Present()
+}
+
+// retainFrame reads the frame about to be presented into lastFrame, reusing its surface while the size holds.
+func (window *Window) retainFrame() {
+	width, height, err := window.Renderer.GetOutputSize()
+	if err == nil && (window.lastFrame == nil || window.lastFrame.W != width || window.lastFrame.H != height) {
+		if window.lastFrame != nil {
+			window.lastFrame.Free()
+		}
+		window.lastFrame, err = sdl.CreateRGBSurfaceWithFormat(0, width, height, 32, sdl.PIXELFORMAT_ARGB8888)
+	}
+	if err == nil {
+		err = window.Renderer.ReadPixels(nil, sdl.PIXELFORMAT_ARGB8888, window.lastFrame.Data(), int(window.lastFrame.Pitch))
+	}
+	if err != nil {
+		GetInternalLogger().Debug("Failed to keep presented frame", "error", err)
+		if window.lastFrame != nil {
+			window.lastFrame.Free()
+			window.lastFrame = nil
+		}
+	}
+}
+
+// CaptureScreenshot saves what is currently drawn on the renderer to a PNG file at filename.
+// It must be called on the render goroutine before the frame is presented; use RequestScreenshot elsewhere.
+func (window *Window) CaptureScreenshot(filename string) error {
+	surface, err := window.readPixels()
+	if err != nil {
+		return err
+	}
+	defer surface.Free()
+
+	if err := img.SavePNG(surface, filename); err != nil {
+		return fmt.Errorf("failed to save screenshot: %w", err)
+	}
+
+	return nil
+}
+
+// CaptureTexture copies the last frame shown by Present into a texture, such as the screen a dialog was
+// opened from. The caller owns the returned texture.
+func (window *Window) CaptureTexture() (*sdl.Texture, error) {
+	if window.lastFrame == nil {
+		return nil, fmt.Errorf("no frame has been presented")
+	}
+
+	texture, err := window.Renderer.CreateTextureFromSurface(window.lastFrame)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create texture: %w", err)
+	}
+	return texture, nil
+}
+
+func (window *Window) readPixels() (*sdl.Surface, error) {
+	width, height, err := window.Renderer.GetOutputSize()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get renderer size: %w", err)
+	}
+
+	surface, err := sdl.CreateRGBSurfaceWithFormat(0, width, height, 32, sdl.PIXELFORMAT_ARGB8888)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create surface: %w", err)
+	}
+
+	if err := window.Renderer.ReadPixels(nil, sdl.PIXELFORMAT_ARGB8888, surface.Data(), int(surface.Pitch)); err != nil {
+		surface.Free()
+		return nil, fmt.Errorf("failed to read pixels: %w", err)
+	}
+
+	return surface, nil
+}
+
+// SetGradientBackground replaces the background with a vertical gradient from top to bottom.
+// The gradient is drawn once into a texture the size of the window.
+func (window *Window) SetGradientBackground(top, bottom sdl.Color) {
+	width, height := window.GetWidth(), window.GetHeight()
+
+	lerp := func(a, b uint8, t float32) uint8 {
+		return uint8(float32(a) + (float32(b)-float32(a))*t)
+	}
+
+	texture, err := RenderToTexture(window.Renderer, width, height, func(renderer *sdl.Renderer) {
+		for y := int32(0); y < height; y++ {
+			t := float32(y) / float32(max(height-1, 1))
+			color := sdl.Color{
+				R: lerp(top.R, bottom.R, t),
+				G: lerp(top.G, bottom.G, t),
+				B: lerp(top.B, bottom.B, t),
+				A: lerp(top.A, bottom.A, t),
+			}
+			gfx.BoxColor(renderer, 0, y, width-1, y, color)
+		}
+	})
+	if err != nil {
+		GetInternalLogger().Error("Failed to create gradient texture", "error", err)
+		return
+	}
+
+	window.ClearGradientBackground()
+	window.gradient = texture
+}
+
+// ClearGradientBackground goes back to the theme's background image.
+func (window *Window) ClearGradientBackground() {
+	if window.gradient != nil {
+		window.gradient.Destroy()
+		window.gradient = nil
 	}
 }
 
@@ -1,7 +1,13 @@
 package gabagool
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
@@ -35,6 +41,10 @@ const (
 	KeyboardLayoutURL
 	// KeyboardLayoutNumeric is a simple numpad for entering numbers.
 	KeyboardLayoutNumeric
+	// KeyboardLayoutAZERTY is the French AZERTY layout.
+	KeyboardLayoutAZERTY
+	// KeyboardLayoutQWERTZ is the German QWERTZ layout.
+	KeyboardLayoutQWERTZ
 )
 
 // URLShortcut represents a shortcut key on the URL keyboard.
@@ -51,6 +61,19 @@ type URLKeyboardConfig struct {
 	// 6-10 shortcuts: two row layout
 	// If empty, 10 default shortcuts are used (two rows).
 	Shortcuts []URLShortcut
+
+	// Backdrop is an optional capture of the previous screen.
+	// When set, the keyboard is drawn over a dimmed copy of it instead of the background.
+	// The caller retains ownership of the texture.
+	Backdrop *sdl.Texture
+
+	// Suggestions are shown as a row of pills above the shortcuts (up to 5).
+	// Navigate up to them and press A to insert one at the cursor.
+	Suggestions []string
+
+	// OnTextChange is called in the background whenever the text changes and returns the new Suggestions.
+	// Setting it reserves the suggestion row even while there are no suggestions.
+	OnTextChange func(text string) []string
 }
 
 type virtualKeyboard struct {
@@ -82,6 +105,38 @@ type virtualKeyboard struct {
 	lastInputTime    time.Time
 	urlShortcuts     []URLShortcut
 	StatusBar        StatusBarOptions
+	themeOverride    *Theme
+	backdrop         *sdl.Texture
+	initialText      string
+	confirmDiscard   bool
+	masked           bool
+	maxLength        int
+	onOverflow       func()
+	clearButton      constants.VirtualButton
+
+	suggestions       []string
+	suggestionRect    sdl.Rect
+	baseKeyLayout     *keyLayout
+	onTextChange      func(text string) []string
+	suggestionResults chan suggestionResult
+	suggestionsDone   chan struct{}
+	suggestionSeq     uint64
+	lastSuggestedText string
+
+	keyEcho     bool
+	echoText    string
+	echoRect    sdl.Rect
+	echoStarted time.Time
+
+	alternates        map[string][]string
+	aHeld             bool
+	showingAlternates bool
+	alternateOptions  []string
+	alternateIndex    int
+
+	// desiredCol is the column vertical navigation aims for, so passing through a shorter row doesn't lose it.
+	// -1 when unset.
+	desiredCol int
 
 	heldDirections struct {
 		up, down, left, right bool
@@ -98,9 +153,12 @@ var defaultKeyboardHelpLines = []string{
 	"• B: Backspace",
 	"• X: Space",
 	"• L1 / R1: Move cursor within text",
+	"• L2: Delete previous word",
+	"• R2: Jump to next word",
 	"• Select: Toggle Shift (uppercase/symbols)",
 	"• Y: Exit keyboard without saving",
 	"• Start: Enter (confirm input)",
+	"• Hold A: Show alternate characters (if any)",
 }
 
 var numericKeyboardHelpLines = []string{
@@ -118,6 +176,8 @@ var urlKeyboardHelpLines = []string{
 	"• B: Backspace",
 	"• X: Toggle symbols (0-9)",
 	"• L1 / R1: Move cursor within text",
+	"• L2: Delete previous word",
+	"• R2: Jump to next word",
 	"• Select: Toggle Shift (uppercase)",
 	"• Y: Exit keyboard without saving",
 	"• Start: Enter (confirm input)",
@@ -136,6 +196,24 @@ var defaultURLShortcuts = []URLShortcut{
 	{Value: ".gov", SymbolValue: ".au"},
 }
 
+// Held directions start repeating after defaultKeyboardRepeatDelay, then move once per defaultKeyboardRepeatInterval.
+const (
+	defaultKeyboardRepeatDelay    = 150 * time.Millisecond
+	defaultKeyboardRepeatInterval = 50 * time.Millisecond
+)
+
+// defaultCursorBlinkRate is how long the text cursor stays on or off.
+const defaultCursorBlinkRate = 500 * time.Millisecond
+
+// maskCharacter replaces each typed character when KeyboardOptions.Masked is set.
+const maskCharacter = "•"
+
+// longPressDuration is how long A must be held on a key before its alternates are shown.
+const longPressDuration = 500 * time.Millisecond
+
+// keyEchoDuration is how long the enlarged key echo stays visible, including its fade out.
+const keyEchoDuration = 600 * time.Millisecond
+
 type keyLayout struct {
 	rows [][]interface{}
 }
@@ -167,14 +245,15 @@ func createKeyboard(windowWidth, windowHeight int32, helpExitText string, layout
 		CursorPosition:   0,
 		CursorVisible:    true,
 		LastCursorBlink:  time.Now(),
-		CursorBlinkRate:  500 * time.Millisecond,
+		CursorBlinkRate:  defaultCursorBlinkRate,
 		helpExitText:     helpExitText,
 		ShowingHelp:      false,
 		InputDelay:       100 * time.Millisecond,
 		lastInputTime:    time.Now(),
 		lastRepeatTime:   time.Now(),
-		repeatDelay:      150 * time.Millisecond,
-		repeatInterval:   50 * time.Millisecond,
+		repeatDelay:      defaultKeyboardRepeatDelay,
+		repeatInterval:   defaultKeyboardRepeatInterval,
+		desiredCol:       -1,
 		StatusBar:        DefaultStatusBarOptions(),
 	}
 
@@ -190,6 +269,16 @@ func createKeyboard(windowWidth, windowHeight int32, helpExitText string, layout
 		kb.keyLayout = createNumericKeyLayout()
 		kb.helpOverlay = newHelpOverlay("Numeric Keyboard Help", numericKeyboardHelpLines, helpExitText)
 		setupNumericKeyboardRects(kb, windowWidth, windowHeight)
+	case KeyboardLayoutAZERTY:
+		kb.Keys = createAZERTYKeys()
+		kb.keyLayout = createRowKeyLayout(azertyRowSizes)
+		kb.helpOverlay = newHelpOverlay("Keyboard Help", defaultKeyboardHelpLines, helpExitText)
+		setupAZERTYKeyboardRects(kb, windowWidth, windowHeight)
+	case KeyboardLayoutQWERTZ:
+		kb.Keys = createQWERTZKeys()
+		kb.keyLayout = createRowKeyLayout(qwertzRowSizes)
+		kb.helpOverlay = newHelpOverlay("Keyboard Help", defaultKeyboardHelpLines, helpExitText)
+		setupQWERTZKeyboardRects(kb, windowWidth, windowHeight)
 	default:
 		kb.Keys = createKeys()
 		kb.keyLayout = createKeyLayout()
@@ -210,14 +299,15 @@ func createURLKeyboard(windowWidth, windowHeight int32, helpExitText string, sho
 		CursorPosition:   0,
 		CursorVisible:    true,
 		LastCursorBlink:  time.Now(),
-		CursorBlinkRate:  500 * time.Millisecond,
+		CursorBlinkRate:  defaultCursorBlinkRate,
 		helpExitText:     helpExitText,
 		ShowingHelp:      false,
 		InputDelay:       100 * time.Millisecond,
 		lastInputTime:    time.Now(),
 		lastRepeatTime:   time.Now(),
-		repeatDelay:      150 * time.Millisecond,
-		repeatInterval:   50 * time.Millisecond,
+		repeatDelay:      defaultKeyboardRepeatDelay,
+		repeatInterval:   defaultKeyboardRepeatInterval,
+		desiredCol:       -1,
 		urlShortcuts:     shortcuts,
 		StatusBar:        DefaultStatusBarOptions(),
 	}
@@ -547,20 +637,37 @@ func createNumericKeys() []key {
 	return keys
 }
 
-func setupKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32) {
+// keyboardMinMargin is the least space kept above the text input and below the keys when they grow with the font scale.
+const keyboardMinMargin = 8
+
+// keyboardArea lays out the text input with the keyboard below it, centered in the window, and returns their rects
+// and the height of a key when the keyboard is divided into rows. Keys grow with the font scale so enlarged labels
+// still fit, taking up the margins around the keyboard before they stop growing.
+func keyboardArea(windowWidth, windowHeight, rows int32) (textInput, keyboard sdl.Rect, keyHeight int32) {
 	keyboardWidth := (windowWidth * 85) / 100
 	keyboardHeight := (windowHeight * 85) / 100
 	textInputHeight := windowHeight / 10
 	keyboardHeight = keyboardHeight - textInputHeight - 20
+
+	keyHeight = int32(float32(keyboardHeight/rows) * internal.GetFontScale())
+	keyHeight = min(keyHeight, (windowHeight-textInputHeight-20-keyboardMinMargin*2)/rows)
+	keyboardHeight = max(keyboardHeight, keyHeight*rows)
+
 	startX := (windowWidth - keyboardWidth) / 2
 	textInputY := (windowHeight - keyboardHeight - textInputHeight - 20) / 2
 	keyboardStartY := textInputY + textInputHeight + 20
 
-	kb.KeyboardRect = sdl.Rect{X: startX, Y: keyboardStartY, W: keyboardWidth, H: keyboardHeight}
-	kb.TextInputRect = sdl.Rect{X: startX, Y: textInputY, W: keyboardWidth, H: textInputHeight}
+	textInput = sdl.Rect{X: startX, Y: textInputY, W: keyboardWidth, H: textInputHeight}
+	keyboard = sdl.Rect{X: startX, Y: keyboardStartY, W: keyboardWidth, H: keyboardHeight}
+	return textInput, keyboard, keyHeight
+}
+
+func setupKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32) {
+	var keyHeight int32
+	kb.TextInputRect, kb.KeyboardRect, keyHeight = keyboardArea(windowWidth, windowHeight, 6)
+	keyboardWidth, startX, keyboardStartY := kb.KeyboardRect.W, kb.KeyboardRect.X, kb.KeyboardRect.Y
 
 	keyWidth := keyboardWidth / 12
-	keyHeight := keyboardHeight / 6
 	keySpacing := int32(3)
 
 	// Define consistent key widths for special keys
@@ -644,20 +751,197 @@ func setupKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32) {
 	kb.SpaceRect = sdl.Rect{X: x, Y: y, W: spaceWidth, H: keyHeight}
 }
 
-func setupURLKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32) {
-	keyboardWidth := (windowWidth * 85) / 100
-	keyboardHeight := (windowHeight * 85) / 100
-	textInputHeight := windowHeight / 10
-	keyboardHeight = keyboardHeight - textInputHeight - 20
-	startX := (windowWidth - keyboardWidth) / 2
-	textInputY := (windowHeight - keyboardHeight - textInputHeight - 20) / 2
-	keyboardStartY := textInputY + textInputHeight + 20
+// keyRowValues holds the characters for one row of a language layout.
+// A nil upper falls back to strings.ToUpper of each lower value.
+type keyRowValues struct {
+	lower   []string
+	upper   []string
+	symbols []string
+}
+
+func buildKeyRows(rows []keyRowValues) []key {
+	var keys []key
+	for _, row := range rows {
+		for i, lower := range row.lower {
+			upper := strings.ToUpper(lower)
+			if row.upper != nil {
+				upper = row.upper[i]
+			}
+			keys = append(keys, key{
+				LowerValue:  lower,
+				UpperValue:  upper,
+				SymbolValue: row.symbols[i],
+			})
+		}
+	}
+	return keys
+}
+
+// Regular key counts per row for the language layouts. Row 5 is always the space bar.
+var (
+	azertyRowSizes = [4]int{10, 10, 10, 7}
+	qwertzRowSizes = [4]int{11, 11, 11, 7}
+)
 
-	kb.KeyboardRect = sdl.Rect{X: startX, Y: keyboardStartY, W: keyboardWidth, H: keyboardHeight}
-	kb.TextInputRect = sdl.Rect{X: startX, Y: textInputY, W: keyboardWidth, H: textInputHeight}
+// createAZERTYKeys returns the French AZERTY keys. Like a physical AZERTY keyboard,
+// the top row types accented letters and punctuation and Shift switches it to digits.
+func createAZERTYKeys() []key {
+	return buildKeyRows([]keyRowValues{
+		{
+			lower:   []string{"&", "é", "\"", "'", "(", "-", "è", "_", "ç", "à"},
+			upper:   []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0"},
+			symbols: []string{"~", "#", "{", "[", "|", "`", "\\", "^", "@", "]"},
+		},
+		{
+			lower:   []string{"a", "z", "e", "r", "t", "y", "u", "i", "o", "p"},
+			symbols: []string{"}", "=", "+", "°", ")", "¨", "µ", "$", "£", "¤"},
+		},
+		{
+			lower:   []string{"q", "s", "d", "f", "g", "h", "j", "k", "l", "m"},
+			symbols: []string{"ù", "%", "*", "§", "!", "/", ".", "?", "€", "<"},
+		},
+		{
+			lower:   []string{"w", "x", "c", "v", "b", "n", ","},
+			upper:   []string{"W", "X", "C", "V", "B", "N", "?"},
+			symbols: []string{";", ":", ">", "«", "»", "œ", "æ"},
+		},
+	})
+}
+
+// createQWERTZKeys returns the German QWERTZ keys, including ß, ü, ö and ä.
+func createQWERTZKeys() []key {
+	return buildKeyRows([]keyRowValues{
+		{
+			lower:   []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "ß"},
+			upper:   []string{"!", "\"", "§", "$", "%", "&", "/", "(", ")", "=", "?"},
+			symbols: []string{"¹", "²", "³", "¼", "½", "¬", "{", "[", "]", "}", "\\"},
+		},
+		{
+			lower:   []string{"q", "w", "e", "r", "t", "z", "u", "i", "o", "p", "ü"},
+			symbols: []string{"@", "~", "€", "#", "'", "`", "´", "^", "°", "|", "+"},
+		},
+		{
+			lower:   []string{"a", "s", "d", "f", "g", "h", "j", "k", "l", "ö", "ä"},
+			symbols: []string{"*", "-", "_", ":", ";", "<", ">", "µ", "=", "&", "%"},
+		},
+		{
+			lower:   []string{"y", "x", "c", "v", "b", "n", "m"},
+			symbols: []string{",", ".", "¿", "¡", "«", "»", "£"},
+		},
+	})
+}
+
+// createRowKeyLayout arranges keys for a layout with the same special keys as KeyboardLayoutGeneral
+// but a different number of keys in each row.
+func createRowKeyLayout(rowSizes [4]int) *keyLayout {
+	rows := make([][]interface{}, 0, 5)
+	index := 0
+	for r, size := range rowSizes {
+		row := make([]interface{}, 0, size+2)
+		if r == 3 {
+			row = append(row, "shift")
+		}
+		for i := 0; i < size; i++ {
+			row = append(row, index)
+			index++
+		}
+		switch r {
+		case 0:
+			row = append(row, "backspace")
+		case 2:
+			row = append(row, "enter")
+		case 3:
+			row = append(row, "symbol")
+		}
+		rows = append(rows, row)
+	}
+	rows = append(rows, []interface{}{"space"})
+
+	return &keyLayout{rows: rows}
+}
+
+func setupAZERTYKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32) {
+	setupRowKeyboardRects(kb, windowWidth, windowHeight, azertyRowSizes)
+}
+
+func setupQWERTZKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32) {
+	setupRowKeyboardRects(kb, windowWidth, windowHeight, qwertzRowSizes)
+}
+
+// setupRowKeyboardRects mirrors setupKeyboardRects for layouts built with createRowKeyLayout,
+// shrinking the keys when the widest row would not fit.
+func setupRowKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32, rowSizes [4]int) {
+	var keyHeight int32
+	kb.TextInputRect, kb.KeyboardRect, keyHeight = keyboardArea(windowWidth, windowHeight, 6)
+	keyboardWidth, startX, keyboardStartY := kb.KeyboardRect.W, kb.KeyboardRect.X, kb.KeyboardRect.Y
+
+	// Row widths in key units: backspace is 2 keys wide, enter 1.5, shift and symbol 2 each
+	maxUnits := 12
+	maxUnits = max(maxUnits, rowSizes[0]+2, rowSizes[1], rowSizes[2]+2, rowSizes[3]+4)
+
+	keyWidth := keyboardWidth / int32(maxUnits)
+	keySpacing := int32(3)
+
+	backspaceWidth := keyWidth * 2
+	shiftWidth := keyWidth * 2
+	symbolWidth := keyWidth * 2
+	enterWidth := keyWidth + keyWidth/2
+	spaceWidth := keyWidth * 8
+
+	rowWidth := func(keys int) int32 {
+		return keyWidth*int32(keys) + keySpacing*int32(keys-1)
+	}
+	row1Width := rowWidth(rowSizes[0]) + keySpacing + backspaceWidth
+	row2Width := rowWidth(rowSizes[1])
+	row3Width := rowWidth(rowSizes[2]) + keySpacing + enterWidth
+	row4Width := shiftWidth + keySpacing + rowWidth(rowSizes[3]) + keySpacing + symbolWidth + keySpacing
+
+	maxRowWidth := max(row1Width, row2Width, row3Width, row4Width)
+	leftMargin := startX + (keyboardWidth-maxRowWidth)/2
+
+	index := 0
+	placeKeys := func(count int, x, y int32) int32 {
+		for i := 0; i < count; i++ {
+			kb.Keys[index].Rect = sdl.Rect{X: x, Y: y, W: keyWidth, H: keyHeight}
+			x += keyWidth + keySpacing
+			index++
+		}
+		return x
+	}
+
+	// Row 1: top row + Backspace
+	y := keyboardStartY + keySpacing
+	x := placeKeys(rowSizes[0], leftMargin+(maxRowWidth-row1Width)/2, y)
+	kb.BackspaceRect = sdl.Rect{X: x, Y: y, W: backspaceWidth, H: keyHeight}
+
+	// Row 2: letters
+	y += keyHeight + keySpacing
+	placeKeys(rowSizes[1], leftMargin+(maxRowWidth-row2Width)/2, y)
+
+	// Row 3: letters + Enter
+	y += keyHeight + keySpacing
+	x = placeKeys(rowSizes[2], leftMargin+(maxRowWidth-row3Width)/2, y)
+	kb.EnterRect = sdl.Rect{X: x, Y: y, W: enterWidth, H: keyHeight}
+
+	// Row 4: Shift + letters + Symbol
+	y += keyHeight + keySpacing
+	x = leftMargin + (maxRowWidth-row4Width)/2
+	kb.ShiftRect = sdl.Rect{X: x, Y: y, W: shiftWidth, H: keyHeight}
+	x = placeKeys(rowSizes[3], x+shiftWidth+keySpacing, y)
+	kb.SymbolRect = sdl.Rect{X: x, Y: y, W: symbolWidth, H: keyHeight}
+
+	// Row 5: Space
+	y += keyHeight + keySpacing
+	x = leftMargin + (maxRowWidth-spaceWidth)/2
+	kb.SpaceRect = sdl.Rect{X: x, Y: y, W: spaceWidth, H: keyHeight}
+}
+
+func setupURLKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32) {
+	var keyHeight int32
+	kb.TextInputRect, kb.KeyboardRect, keyHeight = keyboardArea(windowWidth, windowHeight, 6)
+	keyboardWidth, startX, keyboardStartY := kb.KeyboardRect.W, kb.KeyboardRect.X, kb.KeyboardRect.Y
 
 	keyWidth := keyboardWidth / 12
-	keyHeight := keyboardHeight / 6
 	keySpacing := int32(3)
 
 	// Shortcut keys are wider to fit text like "www." and ".com"
@@ -746,19 +1030,11 @@ func setupURLKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32)
 }
 
 func setupURLKeyboardRectsFor5(kb *virtualKeyboard, windowWidth, windowHeight int32) {
-	keyboardWidth := (windowWidth * 85) / 100
-	keyboardHeight := (windowHeight * 85) / 100
-	textInputHeight := windowHeight / 10
-	keyboardHeight = keyboardHeight - textInputHeight - 20
-	startX := (windowWidth - keyboardWidth) / 2
-	textInputY := (windowHeight - keyboardHeight - textInputHeight - 20) / 2
-	keyboardStartY := textInputY + textInputHeight + 20
-
-	kb.KeyboardRect = sdl.Rect{X: startX, Y: keyboardStartY, W: keyboardWidth, H: keyboardHeight}
-	kb.TextInputRect = sdl.Rect{X: startX, Y: textInputY, W: keyboardWidth, H: textInputHeight}
+	var keyHeight int32
+	kb.TextInputRect, kb.KeyboardRect, keyHeight = keyboardArea(windowWidth, windowHeight, 6)
+	keyboardWidth, startX, keyboardStartY := kb.KeyboardRect.W, kb.KeyboardRect.X, kb.KeyboardRect.Y
 
 	keyWidth := keyboardWidth / 12
-	keyHeight := keyboardHeight / 6
 	keySpacing := int32(3)
 
 	// Shortcut keys are wider to fit text like "https://" and ".com"
@@ -847,19 +1123,11 @@ func setupURLKeyboardRectsFor5(kb *virtualKeyboard, windowWidth, windowHeight in
 }
 
 func setupURLKeyboardRectsFor10(kb *virtualKeyboard, windowWidth, windowHeight int32) {
-	keyboardWidth := (windowWidth * 85) / 100
-	keyboardHeight := (windowHeight * 85) / 100
-	textInputHeight := windowHeight / 10
-	keyboardHeight = keyboardHeight - textInputHeight - 20
-	startX := (windowWidth - keyboardWidth) / 2
-	textInputY := (windowHeight - keyboardHeight - textInputHeight - 20) / 2
-	keyboardStartY := textInputY + textInputHeight + 20
-
-	kb.KeyboardRect = sdl.Rect{X: startX, Y: keyboardStartY, W: keyboardWidth, H: keyboardHeight}
-	kb.TextInputRect = sdl.Rect{X: startX, Y: textInputY, W: keyboardWidth, H: textInputHeight}
+	var keyHeight int32
+	kb.TextInputRect, kb.KeyboardRect, keyHeight = keyboardArea(windowWidth, windowHeight, 7) // 6 rows + some padding
+	keyboardWidth, startX, keyboardStartY := kb.KeyboardRect.W, kb.KeyboardRect.X, kb.KeyboardRect.Y
 
 	keyWidth := keyboardWidth / 12
-	keyHeight := keyboardHeight / 7 // 6 rows + some padding
 	keySpacing := int32(3)
 
 	// Shortcut keys are wider to fit text like "https://" and ".com"
@@ -961,20 +1229,12 @@ func setupURLKeyboardRectsFor10(kb *virtualKeyboard, windowWidth, windowHeight i
 }
 
 func setupNumericKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight int32) {
-	keyboardWidth := (windowWidth * 85) / 100
-	keyboardHeight := (windowHeight * 85) / 100
-	textInputHeight := windowHeight / 10
-	keyboardHeight = keyboardHeight - textInputHeight - 20
-	startX := (windowWidth - keyboardWidth) / 2
-	textInputY := (windowHeight - keyboardHeight - textInputHeight - 20) / 2
-	keyboardStartY := textInputY + textInputHeight + 20
-
-	kb.KeyboardRect = sdl.Rect{X: startX, Y: keyboardStartY, W: keyboardWidth, H: keyboardHeight}
-	kb.TextInputRect = sdl.Rect{X: startX, Y: textInputY, W: keyboardWidth, H: textInputHeight}
+	var keyHeight int32
+	kb.TextInputRect, kb.KeyboardRect, keyHeight = keyboardArea(windowWidth, windowHeight, 5)
+	keyboardWidth, startX, keyboardStartY := kb.KeyboardRect.W, kb.KeyboardRect.X, kb.KeyboardRect.Y
 
 	// Numeric pad uses larger keys since there are fewer
 	keyWidth := keyboardWidth / 5
-	keyHeight := keyboardHeight / 5
 	keySpacing := int32(5)
 
 	backspaceWidth := keyWidth
@@ -1029,6 +1289,80 @@ func setupNumericKeyboardRects(kb *virtualKeyboard, windowWidth, windowHeight in
 	kb.SpaceRect = sdl.Rect{}
 }
 
+// KeyboardOptions configures the Keyboard component.
+type KeyboardOptions struct {
+	// Layout selects the key layout. Defaults to KeyboardLayoutGeneral.
+	Layout KeyboardLayout
+
+	// URLShortcuts replaces the default shortcut keys when Layout is KeyboardLayoutURL (up to 10).
+	// 1-5 shortcuts: single row layout
+	// 6-10 shortcuts: two row layout
+	URLShortcuts []URLShortcut
+
+	// URLSuggestions and OnTextChange drive the suggestion row on KeyboardLayoutURL. See URLKeyboardConfig.
+	URLSuggestions []string
+	OnTextChange   func(text string) []string
+
+	HelpExitText string
+	StatusBar    StatusBarOptions
+
+	// HelpLines replace the layout's help overlay under HelpTitle, e.g. with translated text.
+	// They are shown as given; the ClearButton line is only added to the built-in help.
+	HelpTitle string
+	HelpLines []string
+
+	// Backdrop is an optional capture of the previous screen.
+	// When set, the keyboard is drawn over a dimmed copy of it instead of the background.
+	// The caller retains ownership of the texture.
+	Backdrop *sdl.Texture
+
+	// Alternates are the characters offered when A is held on a key, e.g. "a" -> {"à", "á", "â"}.
+	Alternates map[string][]string
+
+	// ConfirmDiscard asks "Discard changes?" when Y is pressed after the text was edited.
+	// Disabled by default, so Y exits immediately.
+	ConfirmDiscard bool
+
+	// KeyEcho briefly shows an enlarged copy of each typed key above it.
+	KeyEcho bool
+
+	// Masked draws every character as a bullet, for PIN and password entry.
+	// KeyboardResult.Text still holds the real text.
+	Masked bool
+
+	// RepeatDelay is how long a direction must be held before it starts repeating.
+	// RepeatInterval is the time between repeats after that. Zero keeps the default.
+	RepeatDelay    time.Duration
+	RepeatInterval time.Duration
+
+	// MaxLength caps the text at this many characters and shows a counter in the input field. 0 means unlimited.
+	// OnOverflow is called when a key press is rejected because the text is full.
+	MaxLength  int
+	OnOverflow func()
+
+	// CursorBlinkRate is how long the text cursor stays on or off. Zero uses 500ms;
+	// a very large value effectively turns blinking off.
+	CursorBlinkRate time.Duration
+
+	// ClearButton erases all text at once. Defaults to L3 (left stick click); VirtualButtonUnassigned disables it.
+	ClearButton constants.VirtualButton
+
+	// ThemeOverride renders the keyboard with its own theme instead of the global one.
+	ThemeOverride *Theme
+}
+
+// DefaultKeyboardOptions returns the options used by Keyboard and URLKeyboard.
+func DefaultKeyboardOptions() KeyboardOptions {
+	return KeyboardOptions{
+		Layout:          KeyboardLayoutGeneral,
+		StatusBar:       DefaultStatusBarOptions(),
+		RepeatDelay:     defaultKeyboardRepeatDelay,
+		RepeatInterval:  defaultKeyboardRepeatInterval,
+		ClearButton:     constants.VirtualButtonL3,
+		CursorBlinkRate: defaultCursorBlinkRate,
+	}
+}
+
 // KeyboardResult represents the result of the Keyboard component.
 type KeyboardResult struct {
 	Text string
@@ -1039,19 +1373,107 @@ type KeyboardResult struct {
 // If no layout is specified, KeyboardLayoutGeneral is used.
 // Returns ErrCancelled if the user exits without pressing Enter.
 func Keyboard(initialText string, helpExitText string, layout ...KeyboardLayout) (*KeyboardResult, error) {
-	selectedLayout := KeyboardLayoutGeneral
+	return KeyboardWithBackdrop(initialText, helpExitText, nil, layout...)
+}
+
+// KeyboardWithBackdrop displays a virtual keyboard drawn over a dimmed copy of backdrop,
+// so the user keeps the context of the screen they came from.
+// A nil backdrop behaves exactly like Keyboard. The caller retains ownership of the texture.
+func KeyboardWithBackdrop(initialText string, helpExitText string, backdrop *sdl.Texture, layout ...KeyboardLayout) (*KeyboardResult, error) {
+	opts := DefaultKeyboardOptions()
+	opts.HelpExitText = helpExitText
+	opts.Backdrop = backdrop
 	if len(layout) > 0 {
-		selectedLayout = layout[0]
+		opts.Layout = layout[0]
 	}
+	return KeyboardWithOptions(initialText, opts)
+}
+
+// URLKeyboard displays a URL-optimized keyboard with customizable shortcuts.
+// If 1-5 shortcuts are provided, a single row of shortcuts is shown.
+// If 6-10 shortcuts are provided, two rows of shortcuts are shown.
+// If no config is provided, 10 default shortcuts are used (two rows).
+// Returns ErrCancelled if the user exits without pressing Enter.
+func URLKeyboard(initialText string, helpExitText string, config ...URLKeyboardConfig) (*KeyboardResult, error) {
+	opts := DefaultKeyboardOptions()
+	opts.Layout = KeyboardLayoutURL
+	opts.HelpExitText = helpExitText
+	opts.URLShortcuts = defaultURLShortcuts
+	if len(config) > 0 {
+		opts.Backdrop = config[0].Backdrop
+		opts.URLSuggestions = config[0].Suggestions
+		opts.OnTextChange = config[0].OnTextChange
+		if len(config[0].Shortcuts) > 0 {
+			opts.URLShortcuts = config[0].Shortcuts
+		}
+	}
+	return KeyboardWithOptions(initialText, opts)
+}
+
+// KeyboardWithOptions displays a virtual keyboard configured by opts.
+// Start from DefaultKeyboardOptions and override what you need.
+// Returns ErrCancelled if the user exits without pressing Enter.
+func KeyboardWithOptions(initialText string, opts KeyboardOptions) (*KeyboardResult, error) {
+	defer internal.GetInputProcessor().StartCooldown()
 
 	window := internal.GetWindow()
 	renderer := window.Renderer
 	font := internal.Fonts.MediumFont
 
-	kb := createKeyboard(window.GetWidth(), window.GetHeight(), helpExitText, selectedLayout)
+	var kb *virtualKeyboard
+	if opts.Layout == KeyboardLayoutURL && len(opts.URLShortcuts) > 0 {
+		// Use only the provided shortcuts (up to 10)
+		shortcuts := opts.URLShortcuts
+		if len(shortcuts) > 10 {
+			shortcuts = shortcuts[:10]
+		}
+		kb = createURLKeyboard(window.GetWidth(), window.GetHeight(), opts.HelpExitText, shortcuts)
+	} else {
+		kb = createKeyboard(window.GetWidth(), window.GetHeight(), opts.HelpExitText, opts.Layout)
+	}
+
+	kb.StatusBar = opts.StatusBar
+	kb.themeOverride = opts.ThemeOverride
+	kb.backdrop = opts.Backdrop
+	kb.alternates = opts.Alternates
+	kb.confirmDiscard = opts.ConfirmDiscard
+	kb.keyEcho = opts.KeyEcho
+	kb.masked = opts.Masked
+	kb.maxLength = opts.MaxLength
+	kb.onOverflow = opts.OnOverflow
+	kb.clearButton = opts.ClearButton
+	if len(opts.HelpLines) > 0 {
+		kb.helpOverlay = newHelpOverlay(opts.HelpTitle, opts.HelpLines, opts.HelpExitText)
+	} else if kb.clearButton != constants.VirtualButtonUnassigned && kb.helpOverlay != nil {
+		kb.helpOverlay.Lines = append(slices.Clone(kb.helpOverlay.Lines), fmt.Sprintf("• %s: Clear all text", kb.clearButton.GetName()))
+	}
+	if opts.RepeatDelay > 0 {
+		kb.repeatDelay = opts.RepeatDelay
+	}
+	if opts.RepeatInterval > 0 {
+		kb.repeatInterval = opts.RepeatInterval
+	}
+	if opts.CursorBlinkRate > 0 {
+		kb.CursorBlinkRate = opts.CursorBlinkRate
+	}
+	if kb.masked {
+		// Echoing each key would reveal the text being masked
+		kb.keyEcho = false
+	}
+	kb.initialText = initialText
 	if initialText != "" {
 		kb.TextBuffer = initialText
-		kb.CursorPosition = len(initialText)
+		kb.CursorPosition = utf8.RuneCountInString(initialText)
+	}
+
+	if opts.Layout == KeyboardLayoutURL && (len(opts.URLSuggestions) > 0 || opts.OnTextChange != nil) {
+		kb.enableSuggestions(opts.URLSuggestions, opts.OnTextChange)
+		defer kb.stopSuggestions()
+	}
+
+	if kb.alternates != nil {
+		restoreHold := internal.GetInputProcessor().PushHold(constants.VirtualButtonA, longPressDuration, nil)
+		defer restoreHold()
 	}
 
 	for {
@@ -1060,10 +1482,12 @@ func Keyboard(initialText string, helpExitText string, layout ...KeyboardLayout)
 		}
 
 		kb.handleDirectionalRepeats()
+		kb.handleHoldEvents()
+		kb.updateSuggestions()
 
 		kb.updateCursorBlink()
 		kb.render(renderer, font)
-		sdl.Delay(16)
+		sdl.Delay(internal.FrameDelay())
 	}
 
 	if kb.EnterPressed {
@@ -1072,52 +1496,192 @@ func Keyboard(initialText string, helpExitText string, layout ...KeyboardLayout)
 	return nil, ErrCancelled
 }
 
-// URLKeyboard displays a URL-optimized keyboard with customizable shortcuts.
-// If 1-5 shortcuts are provided, a single row of shortcuts is shown.
-// If 6-10 shortcuts are provided, two rows of shortcuts are shown.
-// If no config is provided, 10 default shortcuts are used (two rows).
-// Returns ErrCancelled if the user exits without pressing Enter.
-func URLKeyboard(initialText string, helpExitText string, config ...URLKeyboardConfig) (*KeyboardResult, error) {
-	// Build shortcuts list - use provided shortcuts or defaults
-	var shortcuts []URLShortcut
-	if len(config) > 0 && len(config[0].Shortcuts) > 0 {
-		// Use only the provided shortcuts (up to 10)
-		maxShortcuts := len(config[0].Shortcuts)
-		if maxShortcuts > 10 {
-			maxShortcuts = 10
+// maxURLSuggestions is how many suggestion pills the URL keyboard shows at once.
+const maxURLSuggestions = 5
+
+// suggestionSpecialBase offsets SelectedSpecial for suggestion pills so they don't collide with the special keys.
+const suggestionSpecialBase = 100
+
+type suggestionResult struct {
+	seq         uint64
+	suggestions []string
+}
+
+// enableSuggestions reserves a strip above the first key row for suggestion pills.
+// The key rows are squeezed vertically to make room, so the keyboard keeps its overall size.
+func (kb *virtualKeyboard) enableSuggestions(suggestions []string, onTextChange func(text string) []string) {
+	if len(kb.Keys) == 0 {
+		return
+	}
+
+	kb.onTextChange = onTextChange
+	kb.suggestionResults = make(chan suggestionResult, 16)
+	kb.suggestionsDone = make(chan struct{})
+	kb.lastSuggestedText = kb.TextBuffer
+
+	top := kb.KeyboardRect.Y
+	bottom := top
+	rects := []*sdl.Rect{&kb.BackspaceRect, &kb.EnterRect, &kb.ShiftRect, &kb.SymbolRect}
+	for i := range kb.Keys {
+		rects = append(rects, &kb.Keys[i].Rect)
+	}
+	for _, r := range rects {
+		bottom = max(bottom, r.Y+r.H)
+	}
+
+	keySpacing := int32(3)
+	stripHeight := kb.Keys[0].Rect.H * 3 / 4
+	scale := float32(bottom-top-stripHeight-keySpacing) / float32(bottom-top)
+	for _, r := range rects {
+		if r.H == 0 {
+			continue
 		}
-		shortcuts = config[0].Shortcuts[:maxShortcuts]
+		r.Y = top + stripHeight + keySpacing + int32(float32(r.Y-top)*scale)
+		r.H = int32(float32(r.H) * scale)
+	}
+
+	kb.suggestionRect = sdl.Rect{X: kb.KeyboardRect.X, Y: top, W: kb.KeyboardRect.W, H: stripHeight}
+	kb.baseKeyLayout = kb.keyLayout
+	kb.setSuggestions(suggestions)
+}
+
+// setSuggestions replaces the suggestion pills and rebuilds the navigation rows to match.
+func (kb *virtualKeyboard) setSuggestions(suggestions []string) {
+	if len(suggestions) > maxURLSuggestions {
+		suggestions = suggestions[:maxURLSuggestions]
+	}
+	kb.suggestions = suggestions
+
+	if len(suggestions) == 0 {
+		kb.keyLayout = kb.baseKeyLayout
 	} else {
-		// No config provided, use all 10 defaults
-		shortcuts = defaultURLShortcuts
+		row := make([]interface{}, len(suggestions))
+		for i := range suggestions {
+			row[i] = fmt.Sprintf("suggestion%d", i)
+		}
+		kb.keyLayout = &keyLayout{rows: append([][]interface{}{row}, kb.baseKeyLayout.rows...)}
 	}
 
-	window := internal.GetWindow()
-	renderer := window.Renderer
-	font := internal.Fonts.MediumFont
+	if kb.SelectedSpecial >= suggestionSpecialBase {
+		index := kb.SelectedSpecial - suggestionSpecialBase
+		if len(suggestions) == 0 {
+			kb.setSelection(kb.keyLayout, 0, 0)
+		} else if index >= len(suggestions) {
+			kb.SelectedSpecial = suggestionSpecialBase + len(suggestions) - 1
+		}
+	}
+}
 
-	kb := createURLKeyboard(window.GetWidth(), window.GetHeight(), helpExitText, shortcuts)
-	if initialText != "" {
-		kb.TextBuffer = initialText
-		kb.CursorPosition = len(initialText)
+// updateSuggestions asks OnTextChange for fresh suggestions whenever the text changes
+// and applies the answer for the current text once it arrives.
+func (kb *virtualKeyboard) updateSuggestions() {
+	if kb.onTextChange == nil {
+		return
+	}
+
+	if kb.TextBuffer != kb.lastSuggestedText {
+		kb.lastSuggestedText = kb.TextBuffer
+		kb.suggestionSeq++
+		seq, text := kb.suggestionSeq, kb.TextBuffer
+		results, done := kb.suggestionResults, kb.suggestionsDone
+		go func() {
+			suggestions := kb.onTextChange(text)
+			select {
+			case results <- suggestionResult{seq: seq, suggestions: suggestions}:
+			case <-done:
+			}
+		}()
 	}
 
 	for {
-		if kb.handleEvents() {
-			break
+		select {
+		case result := <-kb.suggestionResults:
+			// Drop answers for text the user has already changed
+			if result.seq == kb.suggestionSeq {
+				kb.setSuggestions(result.suggestions)
+			}
+		default:
+			return
 		}
+	}
+}
 
-		kb.handleDirectionalRepeats()
+// stopSuggestions releases any OnTextChange calls still running when the keyboard closes.
+func (kb *virtualKeyboard) stopSuggestions() {
+	if kb.suggestionsDone != nil {
+		close(kb.suggestionsDone)
+		kb.suggestionsDone = nil
+	}
+}
 
-		kb.updateCursorBlink()
-		kb.render(renderer, font)
-		sdl.Delay(16)
+func (kb *virtualKeyboard) insertSuggestion(index int) {
+	if index >= 0 && index < len(kb.suggestions) {
+		kb.insertText(kb.suggestions[index])
 	}
+}
 
-	if kb.EnterPressed {
-		return &KeyboardResult{Text: kb.TextBuffer}, nil
+func (kb *virtualKeyboard) renderSuggestions(renderer *sdl.Renderer) {
+	if kb.suggestionRect.H == 0 || len(kb.suggestions) == 0 {
+		return
+	}
+
+	font := internal.Fonts.SmallFont
+	spacing := int32(8)
+	textPadding := kb.suggestionRect.H / 2
+	maxPillWidth := kb.suggestionRect.W / 2
+
+	widths := make([]int32, len(kb.suggestions))
+	for i, suggestion := range kb.suggestions {
+		w, _, err := font.SizeUTF8(suggestion)
+		if err != nil {
+			continue
+		}
+		widths[i] = internal.Min32(int32(w)+textPadding*2, maxPillWidth)
+	}
+
+	// Scroll horizontally so the selected pill stays inside the strip
+	selected := kb.SelectedSpecial - suggestionSpecialBase
+	offset := int32(0)
+	if selected >= 0 && selected < len(widths) {
+		end := int32(0)
+		for i := 0; i <= selected; i++ {
+			end += widths[i] + spacing
+		}
+		offset = max(0, end-spacing-kb.suggestionRect.W)
+	}
+
+	renderer.SetClipRect(&kb.suggestionRect)
+	defer renderer.SetClipRect(nil)
+
+	x := kb.suggestionRect.X - offset
+	for i, suggestion := range kb.suggestions {
+		rect := sdl.Rect{X: x, Y: kb.suggestionRect.Y, W: widths[i], H: kb.suggestionRect.H}
+		x += widths[i] + spacing
+
+		bgColor := sdl.Color{R: 50, G: 50, B: 60, A: 255}
+		if i == selected {
+			bgColor = sdl.Color{R: 100, G: 100, B: 240, A: 255}
+		}
+		internal.DrawRoundedRect(renderer, &rect, rect.H/2, bgColor)
+
+		surface, err := font.RenderUTF8Blended(suggestion, sdl.Color{R: 255, G: 255, B: 255, A: 255})
+		if err != nil {
+			continue
+		}
+		texture, err := renderer.CreateTextureFromSurface(surface)
+		if err == nil {
+			src := sdl.Rect{W: internal.Min32(surface.W, rect.W-textPadding*2), H: surface.H}
+			dst := sdl.Rect{
+				X: rect.X + (rect.W-src.W)/2,
+				Y: rect.Y + (rect.H-surface.H)/2,
+				W: src.W,
+				H: surface.H,
+			}
+			renderer.Copy(texture, &src, &dst)
+			texture.Destroy()
+		}
+		surface.Free()
 	}
-	return nil, ErrCancelled
 }
 
 func (kb *virtualKeyboard) handleEvents() bool {
@@ -1128,7 +1692,7 @@ func (kb *virtualKeyboard) handleEvents() bool {
 		case *sdl.QuitEvent:
 			return true
 
-		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent:
+		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
 			inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
 			if inputEvent == nil {
 				continue
@@ -1168,6 +1732,16 @@ func (kb *virtualKeyboard) handleInputEvent(inputEvent *internal.Event) bool {
 		return kb.handleHelpInputEvent(button)
 	}
 
+	if kb.showingAlternates {
+		kb.handleAlternatesInputEvent(button)
+		return false
+	}
+
+	if kb.clearButton != constants.VirtualButtonUnassigned && button == kb.clearButton {
+		kb.clearText()
+		return false
+	}
+
 	// Handle keyboard input
 	switch button {
 	case constants.VirtualButtonUp:
@@ -1195,13 +1769,18 @@ func (kb *virtualKeyboard) handleInputEvent(inputEvent *internal.Event) bool {
 		kb.lastRepeatTime = time.Now()
 		return false
 	case constants.VirtualButtonA:
+		// Keys with alternates insert on release so a long press can open the popup instead
+		if len(kb.currentAlternates()) > 0 {
+			kb.aHeld = true
+			return false
+		}
 		kb.processSelection()
 		return kb.EnterPressed
 	case constants.VirtualButtonB:
 		kb.backspace()
 		return false
 	case constants.VirtualButtonX:
-		if kb.Layout == KeyboardLayoutGeneral {
+		if kb.Layout == KeyboardLayoutGeneral || kb.Layout == KeyboardLayoutAZERTY || kb.Layout == KeyboardLayoutQWERTZ {
 			kb.insertSpace()
 		} else if kb.Layout == KeyboardLayoutURL {
 			kb.toggleSymbols()
@@ -1214,6 +1793,9 @@ func (kb *virtualKeyboard) handleInputEvent(inputEvent *internal.Event) bool {
 		}
 		return false
 	case constants.VirtualButtonY:
+		if kb.confirmDiscard && kb.TextBuffer != kb.initialText {
+			return kb.confirmDiscardChanges()
+		}
 		return true // Exit without saving
 	case constants.VirtualButtonStart:
 		kb.EnterPressed = true
@@ -1224,11 +1806,36 @@ func (kb *virtualKeyboard) handleInputEvent(inputEvent *internal.Event) bool {
 	case constants.VirtualButtonR1:
 		kb.moveCursor(1)
 		return false
+	case constants.VirtualButtonL2:
+		kb.deleteWordLeft()
+		return false
+	case constants.VirtualButtonR2:
+		kb.moveCursorWordRight()
+		return false
 	}
 
 	return false
 }
 
+// confirmDiscardChanges asks the user whether to throw away their edits.
+// Returns true if the keyboard should exit.
+func (kb *virtualKeyboard) confirmDiscardChanges() bool {
+	footerHelpItems := []FooterHelpItem{
+		{ButtonName: "B", HelpText: "Keep Editing"},
+		{ButtonName: "A", HelpText: "Discard"},
+	}
+
+	_, err := ConfirmationMessage("Discard changes?", footerHelpItems, MessageOptions{StatusBar: kb.StatusBar})
+
+	// Presses and releases were consumed by the dialog, so don't carry held state back in
+	kb.heldDirections.up, kb.heldDirections.down = false, false
+	kb.heldDirections.left, kb.heldDirections.right = false, false
+	kb.aHeld = false
+	kb.lastInputTime = time.Now()
+
+	return err == nil
+}
+
 func (kb *virtualKeyboard) isDirectionalButton(button constants.VirtualButton) bool {
 	return button == constants.VirtualButtonUp || button == constants.VirtualButtonDown ||
 		button == constants.VirtualButtonLeft || button == constants.VirtualButtonRight
@@ -1250,6 +1857,13 @@ func (kb *virtualKeyboard) handleHelpInputEvent(button constants.VirtualButton)
 
 func (kb *virtualKeyboard) handleInputEventRelease(inputEvent *internal.Event) {
 	switch inputEvent.Button {
+	case constants.VirtualButtonA:
+		if kb.aHeld {
+			kb.aHeld = false
+			if !kb.showingAlternates {
+				kb.processSelection()
+			}
+		}
 	case constants.VirtualButtonUp:
 		kb.heldDirections.up = false
 		kb.hasRepeated = false
@@ -1296,10 +1910,68 @@ func (kb *virtualKeyboard) handleDirectionalRepeats() {
 	}
 }
 
+func (kb *virtualKeyboard) currentAlternates() []string {
+	if kb.alternates == nil || kb.SelectedKeyIndex < 0 || kb.SelectedKeyIndex >= len(kb.Keys) {
+		return nil
+	}
+	return kb.alternates[kb.getKeyValue(kb.SelectedKeyIndex)]
+}
+
+// handleHoldEvents opens the alternates popup once A has been held on a key for longPressDuration.
+func (kb *virtualKeyboard) handleHoldEvents() {
+	for evt := internal.GetInputProcessor().ProcessHoldEvent(); evt != nil; evt = internal.GetInputProcessor().ProcessHoldEvent() {
+		if evt.Button != constants.VirtualButtonA || !kb.aHeld || kb.showingAlternates {
+			continue
+		}
+
+		kb.alternateOptions = kb.currentAlternates()
+		kb.alternateIndex = 0
+		kb.showingAlternates = len(kb.alternateOptions) > 0
+	}
+}
+
+func (kb *virtualKeyboard) handleAlternatesInputEvent(button constants.VirtualButton) {
+	switch button {
+	case constants.VirtualButtonLeft:
+		kb.alternateIndex--
+		if kb.alternateIndex < 0 {
+			kb.alternateIndex = len(kb.alternateOptions) - 1
+		}
+	case constants.VirtualButtonRight:
+		kb.alternateIndex++
+		if kb.alternateIndex >= len(kb.alternateOptions) {
+			kb.alternateIndex = 0
+		}
+	case constants.VirtualButtonA:
+		kb.insertText(kb.alternateOptions[kb.alternateIndex])
+		kb.startKeyEcho(kb.alternateOptions[kb.alternateIndex], kb.Keys[kb.SelectedKeyIndex].Rect)
+		kb.closeAlternates()
+		kb.CursorVisible = true
+		kb.LastCursorBlink = time.Now()
+	case constants.VirtualButtonB:
+		kb.closeAlternates()
+	}
+}
+
+func (kb *virtualKeyboard) closeAlternates() {
+	kb.showingAlternates = false
+	kb.alternateOptions = nil
+	kb.alternateIndex = 0
+}
+
 func (kb *virtualKeyboard) navigate(button constants.VirtualButton) {
 	layout := kb.keyLayout
 	currentRow, currentCol := kb.findCurrentPosition(layout)
 
+	if button == constants.VirtualButtonUp || button == constants.VirtualButtonDown {
+		if kb.desiredCol < 0 {
+			kb.desiredCol = currentCol
+		}
+		currentCol = kb.desiredCol
+	} else {
+		kb.desiredCol = -1
+	}
+
 	var newRow, newCol int
 	switch button {
 	case constants.VirtualButtonUp:
@@ -1320,6 +1992,9 @@ func (kb *virtualKeyboard) findCurrentPosition(layout *keyLayout) (int, int) {
 
 	if kb.SelectedSpecial > 0 {
 		targetKey := specialKeys[kb.SelectedSpecial]
+		if kb.SelectedSpecial >= suggestionSpecialBase {
+			targetKey = fmt.Sprintf("suggestion%d", kb.SelectedSpecial-suggestionSpecialBase)
+		}
 		for r, row := range layout.rows {
 			for c, key := range row {
 				if str, ok := key.(string); ok && str == targetKey {
@@ -1390,13 +2065,21 @@ func (kb *virtualKeyboard) setSelection(layout *keyLayout, row, col int) {
 		kb.SelectedKeyIndex = -1
 		specialMap := map[string]int{"backspace": 1, "enter": 2, "space": 3, "shift": 4, "symbol": 5}
 		kb.SelectedSpecial = specialMap[str]
+		if index, ok := strings.CutPrefix(str, "suggestion"); ok {
+			n, _ := strconv.Atoi(index)
+			kb.SelectedSpecial = suggestionSpecialBase + n
+		}
 	}
 }
 
 func (kb *virtualKeyboard) processSelection() {
 	if kb.SelectedKeyIndex >= 0 && kb.SelectedKeyIndex < len(kb.Keys) {
 		keyValue := kb.getKeyValue(kb.SelectedKeyIndex)
+		before := kb.TextBuffer
 		kb.insertText(keyValue)
+		if kb.TextBuffer != before {
+			kb.startKeyEcho(keyValue, kb.Keys[kb.SelectedKeyIndex].Rect)
+		}
 	} else {
 		kb.handleSpecialKey()
 	}
@@ -1418,7 +2101,14 @@ func (kb *virtualKeyboard) getKeyValue(index int) string {
 }
 
 func (kb *virtualKeyboard) insertText(text string) {
-	if kb.CursorPosition == len(kb.TextBuffer) {
+	if kb.maxLength > 0 && utf8.RuneCountInString(kb.TextBuffer)+utf8.RuneCountInString(text) > kb.maxLength {
+		if kb.onOverflow != nil {
+			kb.onOverflow()
+		}
+		return
+	}
+
+	if kb.CursorPosition == utf8.RuneCountInString(kb.TextBuffer) {
 		kb.TextBuffer += text
 	} else {
 		textRunes := []rune(kb.TextBuffer)
@@ -1441,6 +2131,10 @@ func (kb *virtualKeyboard) handleSpecialKey() {
 		kb.toggleShift()
 	case 5: // symbol
 		kb.toggleSymbols()
+	default:
+		if kb.SelectedSpecial >= suggestionSpecialBase {
+			kb.insertSuggestion(kb.SelectedSpecial - suggestionSpecialBase)
+		}
 	}
 }
 
@@ -1487,7 +2181,7 @@ func (kb *virtualKeyboard) toggleSymbols() {
 }
 
 func (kb *virtualKeyboard) moveCursor(direction int) {
-	if direction > 0 && kb.CursorPosition < len(kb.TextBuffer) {
+	if direction > 0 && kb.CursorPosition < utf8.RuneCountInString(kb.TextBuffer) {
 		kb.CursorPosition++
 	} else if direction < 0 && kb.CursorPosition > 0 {
 		kb.CursorPosition--
@@ -1497,6 +2191,48 @@ func (kb *virtualKeyboard) moveCursor(direction int) {
 	kb.LastCursorBlink = time.Now()
 }
 
+func (kb *virtualKeyboard) clearText() {
+	kb.TextBuffer = ""
+	kb.CursorPosition = 0
+
+	kb.CursorVisible = true
+	kb.LastCursorBlink = time.Now()
+}
+
+// deleteWordLeft removes the word before the cursor, along with any spaces between it and the cursor.
+func (kb *virtualKeyboard) deleteWordLeft() {
+	textRunes := []rune(kb.TextBuffer)
+	start := kb.CursorPosition
+	for start > 0 && unicode.IsSpace(textRunes[start-1]) {
+		start--
+	}
+	for start > 0 && !unicode.IsSpace(textRunes[start-1]) {
+		start--
+	}
+
+	kb.TextBuffer = string(textRunes[:start]) + string(textRunes[kb.CursorPosition:])
+	kb.CursorPosition = start
+
+	kb.CursorVisible = true
+	kb.LastCursorBlink = time.Now()
+}
+
+// moveCursorWordRight moves the cursor to the end of the next word.
+func (kb *virtualKeyboard) moveCursorWordRight() {
+	textRunes := []rune(kb.TextBuffer)
+	end := kb.CursorPosition
+	for end < len(textRunes) && unicode.IsSpace(textRunes[end]) {
+		end++
+	}
+	for end < len(textRunes) && !unicode.IsSpace(textRunes[end]) {
+		end++
+	}
+	kb.CursorPosition = end
+
+	kb.CursorVisible = true
+	kb.LastCursorBlink = time.Now()
+}
+
 func (kb *virtualKeyboard) updateCursorBlink() {
 	if time.Since(kb.LastCursorBlink) > kb.CursorBlinkRate {
 		kb.CursorVisible = !kb.CursorVisible
@@ -1525,12 +2261,23 @@ func (kb *virtualKeyboard) scrollHelpOverlay(direction int) {
 }
 
 func (kb *virtualKeyboard) render(renderer *sdl.Renderer, font *ttf.Font) {
+	defer internal.OverrideTheme(kb.themeOverride)()
+
 	renderer.SetDrawColor(0, 0, 0, 255)
 	renderer.Clear()
 
 	window := internal.GetWindow()
 
-	if window.Background != nil {
+	if kb.backdrop != nil {
+		renderer.Copy(kb.backdrop, nil, &sdl.Rect{X: 0, Y: 0, W: window.GetWidth(), H: window.GetHeight()})
+
+		var blendMode sdl.BlendMode
+		renderer.GetDrawBlendMode(&blendMode)
+		renderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
+		renderer.SetDrawColor(0, 0, 0, 180)
+		renderer.FillRect(&sdl.Rect{X: 0, Y: 0, W: window.GetWidth(), H: window.GetHeight()})
+		renderer.SetDrawBlendMode(blendMode)
+	} else if window.HasBackground() {
 		window.RenderBackground()
 	} else {
 		renderer.SetDrawColor(0, 0, 0, 255)
@@ -1539,9 +2286,15 @@ func (kb *virtualKeyboard) render(renderer *sdl.Renderer, font *ttf.Font) {
 
 	if !kb.ShowingHelp {
 		kb.renderTextInput(renderer, font)
+		kb.renderSuggestions(renderer)
 		kb.renderKeys(renderer, font)
 		kb.renderSpecialKeys(renderer)
-		renderStatusBar(renderer, internal.Fonts.SmallFont, kb.StatusBar, internal.UniformPadding(20))
+		if kb.showingAlternates {
+			kb.renderAlternates(renderer, font)
+		} else {
+			kb.renderKeyEcho(renderer)
+		}
+		renderStatusBar(renderer, internal.Fonts.SmallFont, kb.StatusBar, internal.UniformPadding(20).WithSafeArea())
 		kb.renderFooter(renderer)
 	}
 
@@ -1549,7 +2302,9 @@ func (kb *virtualKeyboard) render(renderer *sdl.Renderer, font *ttf.Font) {
 		kb.helpOverlay.render(renderer, internal.Fonts.SmallFont)
 	}
 
-	renderer.Present()
+	logInspection("keyboard", InspectKeyboard(kb))
+	internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: kb.SelectedKeyIndex, TextLength: utf8.RuneCountInString(kb.TextBuffer)})
+	window.Present()
 }
 
 func (kb *virtualKeyboard) renderTextInput(renderer *sdl.Renderer, font *ttf.Font) {
@@ -1559,6 +2314,8 @@ func (kb *virtualKeyboard) renderTextInput(renderer *sdl.Renderer, font *ttf.Fon
 	renderer.DrawRect(&kb.TextInputRect)
 
 	padding := int32(10)
+	kb.renderLengthCounter(renderer, padding)
+
 	if kb.TextBuffer != "" {
 		kb.renderTextWithCursor(renderer, font, padding)
 	} else if kb.CursorVisible {
@@ -1568,7 +2325,7 @@ func (kb *virtualKeyboard) renderTextInput(renderer *sdl.Renderer, font *ttf.Fon
 
 func (kb *virtualKeyboard) renderTextWithCursor(renderer *sdl.Renderer, font *ttf.Font, padding int32) {
 	textColor := sdl.Color{R: 255, G: 255, B: 255, A: 255}
-	textSurface, err := font.RenderUTF8Blended(kb.TextBuffer, textColor)
+	textSurface, err := font.RenderUTF8Blended(kb.displayText(), textColor)
 	if err != nil {
 		return
 	}
@@ -1582,7 +2339,7 @@ func (kb *virtualKeyboard) renderTextWithCursor(renderer *sdl.Renderer, font *tt
 
 	// Calculate cursor position and scrolling
 	cursorX := kb.calculateCursorX(font)
-	visibleWidth := kb.TextInputRect.W - (padding * 2)
+	visibleWidth := kb.TextInputRect.W - (padding * 2) - kb.lengthCounterWidth(padding)
 	offsetX := kb.calculateScrollOffset(cursorX, visibleWidth, textSurface.W, padding)
 
 	// Render text
@@ -1632,7 +2389,7 @@ func (kb *virtualKeyboard) calculateCursorX(font *ttf.Font) int32 {
 		return 0
 	}
 
-	cursorText := kb.TextBuffer[:kb.CursorPosition]
+	cursorText := string([]rune(kb.displayText())[:kb.CursorPosition])
 	textColor := sdl.Color{R: 255, G: 255, B: 255, A: 255}
 	cursorSurface, err := font.RenderUTF8Blended(cursorText, textColor)
 	if err != nil {
@@ -1643,6 +2400,67 @@ func (kb *virtualKeyboard) calculateCursorX(font *ttf.Font) int32 {
 	return cursorSurface.W
 }
 
+// lengthCounterText returns the "12/20" counter shown when MaxLength is set, or "" otherwise.
+func (kb *virtualKeyboard) lengthCounterText() string {
+	if kb.maxLength <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", utf8.RuneCountInString(kb.TextBuffer), kb.maxLength)
+}
+
+// lengthCounterWidth is the space the counter takes from the right of the input field, including its gap.
+func (kb *virtualKeyboard) lengthCounterWidth(padding int32) int32 {
+	text := kb.lengthCounterText()
+	if text == "" {
+		return 0
+	}
+	w, _, err := internal.Fonts.SmallFont.SizeUTF8(text)
+	if err != nil {
+		return 0
+	}
+	return int32(w) + padding
+}
+
+func (kb *virtualKeyboard) renderLengthCounter(renderer *sdl.Renderer, padding int32) {
+	text := kb.lengthCounterText()
+	if text == "" {
+		return
+	}
+
+	color := internal.GetTheme().HintColor
+	if utf8.RuneCountInString(kb.TextBuffer) >= kb.maxLength {
+		color = internal.GetTheme().AccentColor
+	}
+
+	surface, err := internal.Fonts.SmallFont.RenderUTF8Blended(text, color)
+	if err != nil {
+		return
+	}
+	defer surface.Free()
+
+	texture, err := renderer.CreateTextureFromSurface(surface)
+	if err != nil {
+		return
+	}
+	defer texture.Destroy()
+
+	rect := sdl.Rect{
+		X: kb.TextInputRect.X + kb.TextInputRect.W - padding - surface.W,
+		Y: kb.TextInputRect.Y + (kb.TextInputRect.H-surface.H)/2,
+		W: surface.W,
+		H: surface.H,
+	}
+	renderer.Copy(texture, nil, &rect)
+}
+
+// displayText is the text drawn in the input field, with every rune replaced by a bullet when masked.
+func (kb *virtualKeyboard) displayText() string {
+	if kb.masked {
+		return strings.Repeat(maskCharacter, utf8.RuneCountInString(kb.TextBuffer))
+	}
+	return kb.TextBuffer
+}
+
 func (kb *virtualKeyboard) calculateScrollOffset(cursorX, visibleWidth, textWidth, padding int32) int32 {
 	offsetX := int32(0)
 	if cursorX > visibleWidth {
@@ -1685,7 +2503,82 @@ func (kb *virtualKeyboard) renderSingleKey(renderer *sdl.Renderer, font *ttf.Fon
 
 func (kb *virtualKeyboard) renderKeyText(renderer *sdl.Renderer, font *ttf.Font, text string, rect sdl.Rect) {
 	textColor := sdl.Color{R: 255, G: 255, B: 255, A: 255}
-	textSurface, err := font.RenderUTF8Blended(text, textColor)
+	textTexture := internal.Glyphs.Texture(renderer, font, text, textColor)
+	if textTexture == nil {
+		return
+	}
+	_, _, w, h, _ := textTexture.Query()
+
+	textRect := sdl.Rect{
+		X: rect.X + (rect.W-w)/2,
+		Y: rect.Y + (rect.H-h)/2,
+		W: w,
+		H: h,
+	}
+	renderer.Copy(textTexture, nil, &textRect)
+}
+
+func (kb *virtualKeyboard) renderAlternates(renderer *sdl.Renderer, font *ttf.Font) {
+	if kb.SelectedKeyIndex < 0 || kb.SelectedKeyIndex >= len(kb.Keys) || len(kb.alternateOptions) == 0 {
+		return
+	}
+
+	keyRect := kb.Keys[kb.SelectedKeyIndex].Rect
+	cellSize := keyRect.H
+	padding := int32(float32(6) * internal.GetScaleFactor())
+
+	popupWidth := int32(len(kb.alternateOptions))*cellSize + padding*2
+	popupHeight := cellSize + padding*2
+
+	popupX := keyRect.X + (keyRect.W-popupWidth)/2
+	popupX = internal.Max32(0, internal.Min32(popupX, internal.GetWindow().GetWidth()-popupWidth))
+	popupY := keyRect.Y - popupHeight - padding
+	if popupY < 0 {
+		popupY = keyRect.Y + keyRect.H + padding
+	}
+
+	popupRect := sdl.Rect{X: popupX, Y: popupY, W: popupWidth, H: popupHeight}
+	internal.DrawRoundedRect(renderer, &popupRect, padding, sdl.Color{R: 30, G: 30, B: 40, A: 255})
+
+	for i, alternate := range kb.alternateOptions {
+		cellRect := sdl.Rect{
+			X: popupX + padding + int32(i)*cellSize,
+			Y: popupY + padding,
+			W: cellSize,
+			H: cellSize,
+		}
+
+		if i == kb.alternateIndex {
+			renderer.SetDrawColor(100, 100, 240, 255)
+			renderer.FillRect(&cellRect)
+		}
+
+		kb.renderKeyText(renderer, font, alternate, cellRect)
+	}
+}
+
+func (kb *virtualKeyboard) startKeyEcho(text string, rect sdl.Rect) {
+	if !kb.keyEcho || text == "" {
+		return
+	}
+	kb.echoText = text
+	kb.echoRect = rect
+	kb.echoStarted = time.Now()
+}
+
+// renderKeyEcho draws the last typed key enlarged above its key, fading out over the last half of keyEchoDuration.
+func (kb *virtualKeyboard) renderKeyEcho(renderer *sdl.Renderer) {
+	elapsed := time.Since(kb.echoStarted)
+	if kb.echoText == "" || elapsed >= keyEchoDuration {
+		return
+	}
+
+	alpha := uint8(255)
+	if fadeStart := keyEchoDuration / 2; elapsed > fadeStart {
+		alpha = uint8(255 * float64(keyEchoDuration-elapsed) / float64(keyEchoDuration-fadeStart))
+	}
+
+	textSurface, err := internal.Fonts.ExtraLargeFont.RenderUTF8Blended(kb.echoText, sdl.Color{R: 255, G: 255, B: 255, A: 255})
 	if err != nil {
 		return
 	}
@@ -1697,13 +2590,31 @@ func (kb *virtualKeyboard) renderKeyText(renderer *sdl.Renderer, font *ttf.Font,
 	}
 	defer textTexture.Destroy()
 
-	textRect := sdl.Rect{
-		X: rect.X + (rect.W-textSurface.W)/2,
-		Y: rect.Y + (rect.H-textSurface.H)/2,
+	padding := int32(float32(10) * internal.GetScaleFactor())
+	popupWidth := internal.Max32(textSurface.W+padding*2, kb.echoRect.W*3/2)
+	popupHeight := textSurface.H + padding*2
+
+	popupX := kb.echoRect.X + (kb.echoRect.W-popupWidth)/2
+	popupX = internal.Max32(0, internal.Min32(popupX, internal.GetWindow().GetWidth()-popupWidth))
+	popupY := kb.echoRect.Y - popupHeight - padding
+	if popupY < 0 {
+		popupY = kb.echoRect.Y + kb.echoRect.H + padding
+	}
+
+	var blendMode sdl.BlendMode
+	renderer.GetDrawBlendMode(&blendMode)
+	renderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
+	popupRect := sdl.Rect{X: popupX, Y: popupY, W: popupWidth, H: popupHeight}
+	internal.DrawRoundedRect(renderer, &popupRect, padding, sdl.Color{R: 100, G: 100, B: 240, A: alpha})
+	renderer.SetDrawBlendMode(blendMode)
+
+	textTexture.SetAlphaMod(alpha)
+	renderer.Copy(textTexture, nil, &sdl.Rect{
+		X: popupX + (popupWidth-textSurface.W)/2,
+		Y: popupY + (popupHeight-textSurface.H)/2,
 		W: textSurface.W,
 		H: textSurface.H,
-	}
-	renderer.Copy(textTexture, nil, &textRect)
+	})
 }
 
 func (kb *virtualKeyboard) renderSpecialKeys(renderer *sdl.Renderer) {
@@ -1788,7 +2699,7 @@ func (kb *virtualKeyboard) renderFooter(renderer *sdl.Renderer) {
 		[]FooterHelpItem{
 			{ButtonName: "Menu", HelpText: "Help"},
 		},
-		20,
+		defaultFooterMargins(),
 		true,
 		true,
 	)
@@ -1,8 +1,11 @@
 package gabagool
 
 import (
+	"slices"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
@@ -14,11 +17,15 @@ import (
 type ListOptions struct {
 	Title             string
 	Items             []MenuItem
+	Sections          []ListSection // When set, replaces Items with the sections' items and draws each Header above its group
 	SelectedIndex     int
 	VisibleStartIndex int
 	MaxVisibleItems   int
+	State             *ListState // Restores a previous ListResult.State; overrides SelectedIndex, VisibleStartIndex and StartInMultiSelectMode
+	FocusKey          string     // Remembers the selection under this key, so the next List with the same key returns to it. Ignored when State is set
 
-	EnableImages bool
+	EnableImages            bool
+	EnableAsyncImageLoading bool // Loads item images in the background, showing a placeholder until they are ready
 
 	StartInMultiSelectMode bool
 	DisableBackButton      bool
@@ -32,6 +39,10 @@ type ListOptions struct {
 	SmallTitle      bool
 	TitleAlign      constants.TextAlign
 	TitleSpacing    int32
+	VerticalAlign   constants.VerticalAlign
+	ExpandableItems bool // A expands the focused item to show its Details inline, B collapses it
+	EnableAlphaJump bool // R2 jumps to the first item of the next letter, L2 to the first item of the previous one
+	RTL             bool // Right-align items, put multiselect checkboxes on the right and show images on the left
 	FooterText      string
 	FooterTextColor sdl.Color
 	FooterHelpItems []FooterHelpItem
@@ -39,6 +50,7 @@ type ListOptions struct {
 
 	ScrollSpeed     float32
 	ScrollPauseTime int
+	TextOverflow    constants.TextOverflow
 
 	InputDelay            time.Duration
 	MultiSelectButton     constants.VirtualButton
@@ -46,14 +58,25 @@ type ListOptions struct {
 	ActionButton          constants.VirtualButton
 	SecondaryActionButton constants.VirtualButton
 	HelpButton            constants.VirtualButton
-	SelectAllButton       constants.VirtualButton
+	SearchButton          constants.VirtualButton // Opens the keyboard to filter items by text; B clears an active filter
+	SelectAllButton       constants.VirtualButton // In multiselect, selects every item, or deselects them all once they are all selected
 	DeselectAllButton     constants.VirtualButton
 
 	EmptyMessage      string
 	EmptyMessageColor sdl.Color
 
+	SearchPlaceholder string // Shown in the search strip while no filter is set
+
 	OnSelect  func(index int, item *MenuItem)
 	OnReorder func(from, to int)
+
+	// OnNeedItems loads items on demand for very large lists. When set, Items is ignored and the list holds TotalItems
+	// entries; only a window around the visible ones is loaded, the rest show a "Loading..." placeholder.
+	// Search and reorder are not available on these lists.
+	OnNeedItems func(startIndex, count int) []MenuItem
+	TotalItems  int
+
+	ThemeOverride *Theme // Renders this list with its own theme instead of the global one
 }
 
 func DefaultListOptions(title string, items []MenuItem) ListOptions {
@@ -65,19 +88,23 @@ func DefaultListOptions(title string, items []MenuItem) ListOptions {
 		Margins:               internal.UniformPadding(20),
 		TitleAlign:            constants.TextAlignLeft,
 		TitleSpacing:          constants.DefaultTitleSpacing,
+		VerticalAlign:         constants.VerticalAlignTop,
 		FooterTextColor:       sdl.Color{R: 180, G: 180, B: 180, A: 255},
 		ScrollSpeed:           4.0,
 		ScrollPauseTime:       1250,
+		TextOverflow:          constants.TextOverflowAuto,
 		InputDelay:            constants.DefaultInputDelay,
 		MultiSelectButton:     constants.VirtualButtonUnassigned,
 		ReorderButton:         constants.VirtualButtonUnassigned,
 		ActionButton:          constants.VirtualButtonUnassigned,
 		SecondaryActionButton: constants.VirtualButtonUnassigned,
 		HelpButton:            constants.VirtualButtonUnassigned,
+		SearchButton:          constants.VirtualButtonUnassigned,
 		SelectAllButton:       constants.VirtualButtonUnassigned,
 		DeselectAllButton:     constants.VirtualButtonUnassigned,
 		EmptyMessage:          "No items available",
 		EmptyMessageColor:     sdl.Color{R: 255, G: 255, B: 255, A: 255},
+		SearchPlaceholder:     "Search",
 		StatusBar:             DefaultStatusBarOptions(),
 	}
 }
@@ -93,8 +120,40 @@ type listController struct {
 
 	helpOverlay     *helpOverlay
 	itemScrollData  map[int]*internal.TextScrollData
+	subtitleData    map[int]*internal.TextScrollData
 	titleScrollData *internal.TextScrollData
 	textureCache    *internal.TextureCache
+	expandedIndex   int
+
+	// With EnableAsyncImageLoading, imageLoads tracks images being decoded (true) or that failed (false),
+	// and decoded images arrive on loadedImages to be turned into textures on the render thread.
+	// imageLoadsDone is closed when the list exits, so loads finishing after that free their image instead.
+	imageLoads     map[string]bool
+	loadedImages   chan loadedImage
+	imageLoadsDone chan struct{}
+	imageLoaders   sync.WaitGroup
+
+	// Frames are only drawn when dirty, while something animates, or every listIdleRedrawInterval so the
+	// status bar stays current. lastView is what the last frame showed, to catch selection and scroll changes.
+	dirty      bool
+	lastRender time.Time
+	lastView   listView
+
+	// sectionStarts holds the index of the first item of each section, in order, with sectionHeaders alongside.
+	// Both are empty when the list has no sections.
+	sectionStarts  []int
+	sectionHeaders []string
+
+	// While a search filter is active, Options.Items holds only the matches.
+	// allItems keeps the full list, and filterMap maps each match back to its index in allItems.
+	filterText        string
+	allItems          []MenuItem
+	allSectionStarts  []int
+	allSectionHeaders []string
+	filterMap         []int
+
+	// The range of items currently loaded through OnNeedItems
+	loadedStart, loadedEnd int
 
 	heldDirections struct {
 		up, down, left, right bool
@@ -106,6 +165,27 @@ type listController struct {
 }
 
 func newListController(options ListOptions) *listController {
+	var sectionStarts []int
+	var sectionHeaders []string
+	if len(options.Sections) > 0 {
+		options.Items = nil
+		for _, section := range options.Sections {
+			if len(section.Items) == 0 {
+				continue
+			}
+			sectionStarts = append(sectionStarts, len(options.Items))
+			sectionHeaders = append(sectionHeaders, section.Header)
+			options.Items = append(options.Items, section.Items...)
+		}
+	}
+
+	if options.OnNeedItems != nil {
+		options.Items = make([]MenuItem, max(options.TotalItems, 0))
+		for i := range options.Items {
+			options.Items[i] = lazyPlaceholder()
+		}
+	}
+
 	selectedItems := make(map[int]bool)
 	if options.SelectedIndex < 0 || options.SelectedIndex >= len(options.Items) {
 		options.SelectedIndex = 0
@@ -122,19 +202,185 @@ func newListController(options ListOptions) *listController {
 		helpOverlay = newHelpOverlay(options.HelpTitle, options.HelpText, options.HelpExitText)
 	}
 
+	multiSelect := options.StartInMultiSelectMode
+	expandedIndex := -1
+	if state := options.State; state != nil {
+		options.SelectedIndex = state.selectedIndex
+		options.VisibleStartIndex = state.visibleStartIndex
+		if options.SelectedIndex < 0 || options.SelectedIndex >= len(options.Items) {
+			options.SelectedIndex = 0
+		}
+		if options.VisibleStartIndex < 0 || options.VisibleStartIndex > options.SelectedIndex {
+			options.VisibleStartIndex = options.SelectedIndex
+		}
+
+		multiSelect = state.multiSelect
+		if multiSelect {
+			selectedItems = make(map[int]bool)
+			for i := range options.Items {
+				options.Items[i].Selected = false
+			}
+			for _, idx := range state.selected {
+				if idx >= 0 && idx < len(options.Items) && !options.Items[idx].NotMultiSelectable {
+					options.Items[idx].Selected = true
+					selectedItems[idx] = true
+				}
+			}
+		}
+
+		if options.ExpandableItems && state.expandedIndex == options.SelectedIndex &&
+			options.SelectedIndex < len(options.Items) && options.Items[options.SelectedIndex].Details != "" {
+			expandedIndex = state.expandedIndex
+		}
+	}
+
+	options.Margins = options.Margins.WithSafeArea()
+
 	return &listController{
-		Options:         options,
-		SelectedItems:   selectedItems,
-		MultiSelect:     options.StartInMultiSelectMode,
-		StartY:          20,
-		lastInputTime:   time.Now(),
-		helpOverlay:     helpOverlay,
-		itemScrollData:  make(map[int]*internal.TextScrollData),
-		titleScrollData: &internal.TextScrollData{},
-		textureCache:    internal.NewTextureCache(),
-		lastRepeatTime:  time.Now(),
-		repeatDelay:     150 * time.Millisecond,
-		repeatInterval:  50 * time.Millisecond,
+		Options:           options,
+		SelectedItems:     selectedItems,
+		MultiSelect:       multiSelect,
+		StartY:            20 + internal.GetSafeArea().Top,
+		lastInputTime:     time.Now(),
+		helpOverlay:       helpOverlay,
+		itemScrollData:    make(map[int]*internal.TextScrollData),
+		subtitleData:      make(map[int]*internal.TextScrollData),
+		titleScrollData:   &internal.TextScrollData{},
+		textureCache:      internal.NewTextureCache(),
+		imageLoads:        make(map[string]bool),
+		loadedImages:      make(chan loadedImage, 8),
+		imageLoadsDone:    make(chan struct{}),
+		dirty:             true,
+		expandedIndex:     expandedIndex,
+		sectionStarts:     sectionStarts,
+		sectionHeaders:    sectionHeaders,
+		allItems:          options.Items,
+		allSectionStarts:  sectionStarts,
+		allSectionHeaders: sectionHeaders,
+		lastRepeatTime:    time.Now(),
+		repeatDelay:       150 * time.Millisecond,
+		repeatInterval:    50 * time.Millisecond,
+	}
+}
+
+// listIdleRedrawInterval is how often an unchanged list is redrawn anyway, for the status bar clock and icons.
+const listIdleRedrawInterval = 500 * time.Millisecond
+
+// listView is the part of the list's state that changes what is on screen.
+type listView struct {
+	selectedIndex     int
+	visibleStartIndex int
+	itemCount         int
+	expandedIndex     int
+	filterText        string
+}
+
+func (lc *listController) view() listView {
+	return listView{
+		selectedIndex:     lc.Options.SelectedIndex,
+		visibleStartIndex: lc.Options.VisibleStartIndex,
+		itemCount:         len(lc.Options.Items),
+		expandedIndex:     lc.expandedIndex,
+		filterText:        lc.filterText,
+	}
+}
+
+// needsRender reports whether the next frame differs from the one on screen.
+func (lc *listController) needsRender() bool {
+	if lc.dirty || lc.view() != lc.lastView || time.Since(lc.lastRender) >= listIdleRedrawInterval ||
+		internal.GetWindow().ScreenshotPending() {
+		return true
+	}
+	return lc.isAnimating()
+}
+
+// isAnimating reports whether anything on screen changes from frame to frame without input:
+// scrolling text, or images still loading in the background.
+func (lc *listController) isAnimating() bool {
+	// Keep the overlay's frame rate live
+	if internal.DebugOverlay.Enabled() {
+		return true
+	}
+
+	for _, loading := range lc.imageLoads {
+		if loading {
+			return true
+		}
+	}
+
+	if internal.ReducedMotion() {
+		return false
+	}
+
+	if lc.titleScrollData.NeedsScrolling {
+		return true
+	}
+	for _, idx := range lc.visibleIndices() {
+		if data, exists := lc.itemScrollData[idx]; exists && data.NeedsScrolling {
+			return true
+		}
+		if data, exists := lc.subtitleData[idx]; exists && data.NeedsScrolling {
+			return true
+		}
+	}
+	return false
+}
+
+// listFocusState is where a list with a FocusKey was left
+type listFocusState struct {
+	selectedIndex     int
+	visibleStartIndex int
+}
+
+var (
+	listFocusMu sync.Mutex
+	listFocus   = map[string]listFocusState{}
+)
+
+// restoreListFocus applies the selection remembered for options.FocusKey, if there is one.
+func restoreListFocus(options *ListOptions) {
+	if options.FocusKey == "" || options.State != nil {
+		return
+	}
+
+	listFocusMu.Lock()
+	focus, ok := listFocus[options.FocusKey]
+	listFocusMu.Unlock()
+	if !ok {
+		return
+	}
+
+	options.SelectedIndex = focus.selectedIndex
+	options.VisibleStartIndex = max(0, min(focus.visibleStartIndex, focus.selectedIndex))
+}
+
+func (lc *listController) saveFocus() {
+	if lc.Options.FocusKey == "" {
+		return
+	}
+
+	listFocusMu.Lock()
+	defer listFocusMu.Unlock()
+	listFocus[lc.Options.FocusKey] = listFocusState{
+		selectedIndex:     lc.originalIndex(lc.Options.SelectedIndex),
+		visibleStartIndex: lc.Options.VisibleStartIndex,
+	}
+}
+
+// state snapshots the current view so a later List call can restore it
+func (lc *listController) state() *ListState {
+	selected := lc.getSelectedItems()
+	for i := range selected {
+		selected[i] = lc.originalIndex(selected[i])
+	}
+
+	return &ListState{
+		selectedIndex:     lc.originalIndex(lc.Options.SelectedIndex),
+		visibleStartIndex: lc.Options.VisibleStartIndex,
+		multiSelect:       lc.MultiSelect,
+		selected:          selected,
+		expandedIndex:     lc.originalIndex(lc.expandedIndex),
+		filterText:        lc.filterText,
 	}
 }
 
@@ -142,9 +388,23 @@ func (lc *listController) cleanup() {
 	if lc.textureCache != nil {
 		lc.textureCache.Destroy()
 	}
+
+	// Free anything decoded after the last frame once the loads still running have given up
+	close(lc.imageLoadsDone)
+	go func() {
+		lc.imageLoaders.Wait()
+		close(lc.loadedImages)
+		for loaded := range lc.loadedImages {
+			if loaded.surface != nil {
+				loaded.surface.Free()
+			}
+		}
+	}()
 }
 
 func List(options ListOptions) (*ListResult, error) {
+	defer internal.GetInputProcessor().StartCooldown()
+
 	window := internal.GetWindow()
 	renderer := window.Renderer
 
@@ -152,13 +412,25 @@ func List(options ListOptions) (*ListResult, error) {
 		options.MaxVisibleItems = 9
 	}
 
+	restoreListFocus(&options)
+
 	lc := newListController(options)
 	defer lc.cleanup()
 
+	// A remembered selection past the end of a list that has since shrunk was reset to 0
+	if lc.Options.VisibleStartIndex > lc.Options.SelectedIndex {
+		lc.Options.VisibleStartIndex = lc.Options.SelectedIndex
+	}
+
 	lc.Options.MaxVisibleItems = int(lc.calculateMaxVisibleItems(window))
 
-	if options.SelectedIndex > 0 {
-		lc.scrollTo(options.SelectedIndex)
+	if options.State != nil && options.State.filterText != "" {
+		lc.applyFilter(options.State.filterText)
+		lc.Options.VisibleStartIndex = max(0, min(options.State.visibleStartIndex, lc.Options.SelectedIndex))
+	}
+
+	if lc.Options.SelectedIndex > 0 {
+		lc.scrollTo(lc.Options.SelectedIndex)
 	}
 
 	running := true
@@ -171,19 +443,21 @@ func List(options ListOptions) (*ListResult, error) {
 
 	for running {
 		// Use WaitEventTimeout to reduce CPU usage when idle
-		// 16ms timeout gives ~60fps max while allowing CPU to sleep
-		if event := sdl.WaitEventTimeout(16); event != nil {
+		// The timeout is the frame budget (~60fps by default) while allowing CPU to sleep
+		if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
+			lc.dirty = true
+
 			switch event.(type) {
 			case *sdl.QuitEvent:
 				running = false
-			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent:
+			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
 				lc.handleInput(event, &running, &result, &cancelled)
 			case *sdl.WindowEvent:
 				we := event.(*sdl.WindowEvent)
 				if we.Event == sdl.WINDOWEVENT_RESIZED {
 					newMaxItems := lc.calculateMaxVisibleItems(window)
 					lc.Options.MaxVisibleItems = int(newMaxItems)
-					if lc.Options.SelectedIndex >= lc.Options.VisibleStartIndex+lc.Options.MaxVisibleItems {
+					if lc.Options.SelectedIndex >= lc.Options.VisibleStartIndex+lc.visibleItemCount(lc.Options.VisibleStartIndex) {
 						lc.scrollTo(lc.Options.SelectedIndex)
 					}
 				}
@@ -192,16 +466,29 @@ func List(options ListOptions) (*ListResult, error) {
 
 		lc.handleDirectionalRepeats()
 
+		if !lc.needsRender() {
+			continue
+		}
+
 		renderer.SetDrawColor(0, 0, 0, 255)
 		renderer.Clear()
 		renderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
 
 		lc.render(window)
-		renderer.Present()
+		logInspection("list", InspectList(lc))
+		internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: lc.Options.SelectedIndex, TextLength: -1})
+		window.Present()
+
+		lc.dirty = false
+		lc.lastRender = time.Now()
+		lc.lastView = lc.view()
 	}
 
 	// Update result with final item order (in case items were reordered)
+	result.State = lc.state()
+	lc.saveFocus()
 	result.Items = lc.Options.Items
+	lc.finishFilter(&result)
 
 	if cancelled {
 		return &result, ErrCancelled
@@ -301,6 +588,11 @@ func (lc *listController) handleNavigation(button constants.VirtualButton) bool
 		direction = "right"
 		lc.heldDirections.right = true
 		lc.heldDirections.left = false
+	case constants.VirtualButtonL2, constants.VirtualButtonR2:
+		if lc.Options.EnableAlphaJump && !lc.ReorderMode {
+			lc.jumpToNextLetter(button == constants.VirtualButtonR2)
+			return true
+		}
 	default:
 	}
 
@@ -313,7 +605,32 @@ func (lc *listController) handleNavigation(button constants.VirtualButton) bool
 }
 
 func (lc *listController) handleActionButtons(button constants.VirtualButton, running *bool, result *ListResult, cancelled *bool) {
-	if len(lc.Options.Items) == 0 && button != constants.VirtualButtonB && button != constants.VirtualButtonMenu {
+	if len(lc.Options.Items) == 0 && button != constants.VirtualButtonB && button != constants.VirtualButtonMenu &&
+		button != lc.Options.SearchButton {
+		return
+	}
+
+	if lc.expandedIndex >= 0 && button == constants.VirtualButtonB {
+		lc.collapseItem()
+		return
+	}
+
+	if footerButtonDisabled(lc.Options.FooterHelpItems, button) {
+		return
+	}
+
+	if lc.Options.SearchButton != constants.VirtualButtonUnassigned && button == lc.Options.SearchButton && lc.Options.OnNeedItems == nil {
+		lc.openSearch()
+		return
+	}
+
+	if lc.filterText != "" && button == constants.VirtualButtonB {
+		lc.applyFilter("")
+		return
+	}
+
+	if button == constants.VirtualButtonA && lc.canExpand(lc.Options.SelectedIndex) {
+		lc.expandItem(lc.Options.SelectedIndex)
 		return
 	}
 
@@ -395,14 +712,19 @@ func (lc *listController) handleActionButtons(button constants.VirtualButton, ru
 	}
 
 	if lc.Options.ReorderButton != constants.VirtualButtonUnassigned &&
-		button == lc.Options.ReorderButton && len(lc.Options.Items) > 0 &&
+		button == lc.Options.ReorderButton && len(lc.Options.Items) > 0 && lc.filterMap == nil && lc.Options.OnNeedItems == nil &&
 		!lc.Options.Items[lc.Options.SelectedIndex].NotReorderable {
 		lc.ReorderMode = !lc.ReorderMode
 	}
 
 	if lc.Options.SelectAllButton != constants.VirtualButtonUnassigned &&
-		button == lc.Options.SelectAllButton && lc.MultiSelect && len(lc.Options.Items) > 0 {
-		lc.selectAll()
+		button == lc.Options.SelectAllButton && lc.MultiSelect && len(lc.Options.Items) > 0 &&
+		button != lc.Options.MultiSelectButton && button != lc.Options.DeselectAllButton {
+		if lc.allSelected() {
+			lc.deselectAll()
+		} else {
+			lc.selectAll()
+		}
 	}
 
 	if lc.Options.DeselectAllButton != constants.VirtualButtonUnassigned &&
@@ -411,12 +733,56 @@ func (lc *listController) handleActionButtons(button constants.VirtualButton, ru
 	}
 }
 
+func (lc *listController) canExpand(index int) bool {
+	return lc.Options.ExpandableItems && !lc.MultiSelect && lc.expandedIndex != index &&
+		index >= 0 && index < len(lc.Options.Items) && lc.Options.Items[index].Details != ""
+}
+
+func (lc *listController) expandItem(index int) {
+	lc.expandedIndex = index
+	lc.Options.MaxVisibleItems = int(lc.calculateMaxVisibleItems(internal.GetWindow()))
+	lc.scrollTo(index)
+}
+
+func (lc *listController) collapseItem() {
+	lc.expandedIndex = -1
+	lc.Options.MaxVisibleItems = int(lc.calculateMaxVisibleItems(internal.GetWindow()))
+	lc.scrollTo(lc.Options.SelectedIndex)
+}
+
+// detailLines returns the lines shown below an expanded item.
+func (lc *listController) detailLines(index int) []string {
+	if index < 0 || index >= len(lc.Options.Items) {
+		return nil
+	}
+	details := lc.Options.Items[index].Details
+	if details == "" {
+		return nil
+	}
+	normalized := strings.ReplaceAll(strings.ReplaceAll(details, "\r\n", "\n"), "\r", "\n")
+	return strings.Split(normalized, "\n")
+}
+
+// expandedHeight is the extra vertical space taken by the expanded item's details.
+func (lc *listController) expandedHeight() int32 {
+	lines := lc.detailLines(lc.expandedIndex)
+	if len(lines) == 0 {
+		return 0
+	}
+	padding := int32(float32(10) * internal.GetScaleFactor())
+	return int32(len(lines))*int32(internal.Fonts.TinyFont.Height()) + padding*2
+}
+
 func (lc *listController) navigate(direction string) {
 	if time.Since(lc.lastInputTime) < lc.Options.InputDelay {
 		return
 	}
 	lc.lastInputTime = time.Now()
 
+	if lc.expandedIndex >= 0 {
+		lc.collapseItem()
+	}
+
 	switch direction {
 	case "up":
 		if lc.ReorderMode {
@@ -451,26 +817,26 @@ func (lc *listController) moveSelection(delta int) {
 	// Handle wrapping and page jumps
 	if delta == 1 { // Down
 		if newIndex >= len(lc.Options.Items) {
-			newIndex = 0
+			newIndex = lc.skipPinned(0, 1)
 			lc.Options.VisibleStartIndex = 0
 		}
 	} else if delta == -1 { // Up
 		if newIndex < 0 {
-			newIndex = len(lc.Options.Items) - 1
+			newIndex = lc.skipPinned(len(lc.Options.Items)-1, -1)
 			if len(lc.Options.Items) > lc.Options.MaxVisibleItems {
 				lc.Options.VisibleStartIndex = len(lc.Options.Items) - lc.Options.MaxVisibleItems
 			}
 		}
 	} else { // Page jumps
 		if delta > 0 { // Page right
-			firstOffScreen := lc.Options.VisibleStartIndex + lc.Options.MaxVisibleItems
+			firstOffScreen := lc.Options.VisibleStartIndex + lc.visibleItemCount(lc.Options.VisibleStartIndex)
 			if firstOffScreen < len(lc.Options.Items) {
 				// There are off-screen items to the right - skip to them
 				newIndex = firstOffScreen
 				lc.Options.VisibleStartIndex = firstOffScreen
 			} else {
 				// No off-screen items - go to bottom of current visible page
-				newIndex = min(lc.Options.VisibleStartIndex+lc.Options.MaxVisibleItems-1, len(lc.Options.Items)-1)
+				newIndex = min(firstOffScreen-1, len(lc.Options.Items)-1)
 			}
 		} else { // Page left
 			if lc.Options.SelectedIndex != lc.Options.VisibleStartIndex {
@@ -491,11 +857,180 @@ func (lc *listController) moveSelection(delta int) {
 		}
 	}
 
+	if delta != 1 && delta != -1 {
+		// Page jumps land in the scrolling part of the list; pinned items are reached with up/down
+		newIndex = lc.skipPinned(newIndex, delta)
+	}
+
 	lc.Options.SelectedIndex = newIndex
 	lc.scrollTo(newIndex)
 	lc.updateSelectionState()
 }
 
+// jumpToNextLetter moves the selection to the first item whose text starts with a different letter than the focused one.
+// Going backward lands on the first item of the previous letter's run, so sorted lists jump letter by letter.
+func (lc *listController) jumpToNextLetter(forward bool) {
+	if time.Since(lc.lastInputTime) < lc.Options.InputDelay {
+		return
+	}
+	lc.lastInputTime = time.Now()
+
+	current := itemLetter(lc.Options.Items[lc.Options.SelectedIndex])
+	target := -1
+
+	if forward {
+		for i := lc.Options.SelectedIndex + 1; i < len(lc.Options.Items); i++ {
+			if !lc.Options.Items[i].Pinned && itemLetter(lc.Options.Items[i]) != current {
+				target = i
+				break
+			}
+		}
+	} else {
+		for i := lc.Options.SelectedIndex - 1; i >= 0; i-- {
+			if lc.Options.Items[i].Pinned {
+				continue
+			}
+			letter := itemLetter(lc.Options.Items[i])
+			if target >= 0 && letter != itemLetter(lc.Options.Items[target]) {
+				break
+			}
+			if letter != current || target >= 0 {
+				target = i
+			}
+		}
+	}
+
+	if target < 0 {
+		return
+	}
+
+	if lc.expandedIndex >= 0 {
+		lc.collapseItem()
+	}
+
+	lc.Options.SelectedIndex = target
+	lc.scrollTo(target)
+	lc.updateSelectionState()
+}
+
+// itemLetter returns the upper-cased first letter of an item's text, or 0 when the text is empty.
+func itemLetter(item MenuItem) rune {
+	for _, r := range strings.TrimSpace(item.Text) {
+		return unicode.ToUpper(r)
+	}
+	return 0
+}
+
+// lazyPlaceholder stands in for an item that has not been loaded through OnNeedItems.
+func lazyPlaceholder() MenuItem {
+	return MenuItem{Text: "Loading...", NotReorderable: true}
+}
+
+// loadVisibleItems keeps a window of items around the visible ones loaded through OnNeedItems.
+// Once scrolling comes within a page of either edge of the window, the window is moved: missing items are fetched and
+// items that fell out of it go back to placeholders.
+func (lc *listController) loadVisibleItems() {
+	if lc.Options.OnNeedItems == nil || len(lc.Options.Items) == 0 {
+		return
+	}
+
+	page := max(lc.Options.MaxVisibleItems, 1)
+	total := len(lc.Options.Items)
+	visibleStart := lc.Options.VisibleStartIndex
+
+	if lc.loadedEnd > lc.loadedStart &&
+		max(0, visibleStart-page) >= lc.loadedStart && min(total, visibleStart+page*2) <= lc.loadedEnd {
+		return
+	}
+
+	start := max(0, visibleStart-page*2)
+	end := min(total, visibleStart+page*3)
+
+	if end <= lc.loadedStart || start >= lc.loadedEnd {
+		lc.fetchItems(start, end)
+	} else {
+		lc.fetchItems(start, lc.loadedStart)
+		lc.fetchItems(lc.loadedEnd, end)
+	}
+
+	for i := lc.loadedStart; i < lc.loadedEnd; i++ {
+		if i < start || i >= end {
+			placeholder := lazyPlaceholder()
+			placeholder.Selected = lc.Options.Items[i].Selected
+			lc.Options.Items[i] = placeholder
+			delete(lc.itemScrollData, i)
+			delete(lc.subtitleData, i)
+		}
+	}
+
+	lc.loadedStart, lc.loadedEnd = start, end
+}
+
+// fetchItems loads items [from, to) through OnNeedItems. Missing entries in the reply stay placeholders.
+func (lc *listController) fetchItems(from, to int) {
+	if from >= to {
+		return
+	}
+
+	items := lc.Options.OnNeedItems(from, to-from)
+	for i := from; i < to; i++ {
+		item := lazyPlaceholder()
+		if i-from < len(items) {
+			item = items[i-from]
+		}
+		item.Selected = lc.SelectedItems[i]
+		lc.Options.Items[i] = item
+		delete(lc.itemScrollData, i)
+		delete(lc.subtitleData, i)
+	}
+}
+
+// skipPinned returns the first item from index, stepping in the direction of step, that is not pinned.
+// If every remaining item is pinned, index is returned unchanged.
+func (lc *listController) skipPinned(index, step int) int {
+	if step > 0 {
+		step = 1
+	} else {
+		step = -1
+	}
+	for i := index; i >= 0 && i < len(lc.Options.Items); i += step {
+		if !lc.Options.Items[i].Pinned {
+			return i
+		}
+	}
+	return index
+}
+
+// pinnedCount returns how many items are pinned to the top of the list.
+func (lc *listController) pinnedCount() int {
+	count := 0
+	for _, item := range lc.Options.Items {
+		if item.Pinned {
+			count++
+		}
+	}
+	return count
+}
+
+// visibleIndices returns the items on screen in display order: pinned items first, then the scrolled window.
+func (lc *listController) visibleIndices() []int {
+	start := lc.Options.VisibleStartIndex
+	end := min(start+lc.visibleItemCount(start), len(lc.Options.Items))
+
+	indices := make([]int, 0, end-start)
+	for i, item := range lc.Options.Items {
+		if item.Pinned {
+			indices = append(indices, i)
+		}
+	}
+	for i := start; i < end; i++ {
+		if !lc.Options.Items[i].Pinned {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
 func (lc *listController) moveItem(delta int) {
 	if delta == 1 && lc.Options.SelectedIndex >= len(lc.Options.Items)-1 {
 		return
@@ -544,6 +1079,11 @@ func (lc *listController) moveItemOneStep(direction int) bool {
 		return false
 	}
 
+	// Items stay within their section
+	if lc.sectionOf(currentIndex) != lc.sectionOf(targetIndex) {
+		return false
+	}
+
 	// Swap items
 	lc.Options.Items[currentIndex], lc.Options.Items[targetIndex] = lc.Options.Items[targetIndex], lc.Options.Items[currentIndex]
 
@@ -608,6 +1148,21 @@ func (lc *listController) selectAll() {
 	}
 }
 
+// allSelected reports whether every item that can be selected is selected.
+func (lc *listController) allSelected() bool {
+	selectable := false
+	for _, item := range lc.Options.Items {
+		if item.NotMultiSelectable {
+			continue
+		}
+		if !item.Selected {
+			return false
+		}
+		selectable = true
+	}
+	return selectable
+}
+
 func (lc *listController) deselectAll() {
 	for i := range lc.Options.Items {
 		lc.Options.Items[i].Selected = false
@@ -633,13 +1188,21 @@ func (lc *listController) getSelectedItems() []int {
 }
 
 func (lc *listController) scrollTo(index int) {
+	if index >= 0 && index < len(lc.Options.Items) && lc.Options.Items[index].Pinned {
+		return
+	}
+
 	if index < lc.Options.VisibleStartIndex {
 		lc.Options.VisibleStartIndex = index
-	} else if index >= lc.Options.VisibleStartIndex+lc.Options.MaxVisibleItems {
+	} else if index >= lc.Options.VisibleStartIndex+lc.visibleItemCount(lc.Options.VisibleStartIndex) {
 		lc.Options.VisibleStartIndex = index - lc.Options.MaxVisibleItems + 1
 		if lc.Options.VisibleStartIndex < 0 {
 			lc.Options.VisibleStartIndex = 0
 		}
+		// Section headers take room, so fewer items may fit than MaxVisibleItems
+		for index >= lc.Options.VisibleStartIndex+lc.visibleItemCount(lc.Options.VisibleStartIndex) {
+			lc.Options.VisibleStartIndex++
+		}
 	}
 }
 
@@ -675,24 +1238,36 @@ func (lc *listController) handleDirectionalRepeats() {
 }
 
 func (lc *listController) render(window *internal.Window) {
+	defer internal.OverrideTheme(lc.Options.ThemeOverride)()
+
+	if lc.Options.EnableAsyncImageLoading {
+		lc.receiveLoadedImages(window.Renderer)
+	}
+
+	lc.loadVisibleItems()
 	lc.updateScrolling()
 
 	for i := range lc.Options.Items {
 		lc.Options.Items[i].Focused = i == lc.Options.SelectedIndex
 	}
 
-	endIndex := min(lc.Options.VisibleStartIndex+lc.Options.MaxVisibleItems, len(lc.Options.Items))
-	visibleItems := make([]MenuItem, endIndex-lc.Options.VisibleStartIndex)
-	copy(visibleItems, lc.Options.Items[lc.Options.VisibleStartIndex:endIndex])
+	indices := lc.visibleIndices()
+	visibleItems := make([]MenuItem, len(indices))
+	for i, index := range indices {
+		visibleItems[i] = lc.Options.Items[index]
+	}
 
 	if lc.ReorderMode {
-		selectedIdx := lc.Options.SelectedIndex - lc.Options.VisibleStartIndex
-		if selectedIdx >= 0 && selectedIdx < len(visibleItems) {
-			visibleItems[selectedIdx].Text = "↕ " + visibleItems[selectedIdx].Text
+		if selectedIdx := slices.Index(indices, lc.Options.SelectedIndex); selectedIdx >= 0 {
+			if lc.Options.RTL {
+				visibleItems[selectedIdx].Text = visibleItems[selectedIdx].Text + " ↕"
+			} else {
+				visibleItems[selectedIdx].Text = "↕ " + visibleItems[selectedIdx].Text
+			}
 		}
 	}
 
-	lc.renderContent(window, visibleItems)
+	lc.renderContent(window, visibleItems, indices)
 
 	if lc.ShowingHelp && lc.helpOverlay != nil {
 		lc.helpOverlay.ShowingHelp = true
@@ -700,7 +1275,7 @@ func (lc *listController) render(window *internal.Window) {
 	}
 }
 
-func (lc *listController) renderContent(window *internal.Window, visibleItems []MenuItem) {
+func (lc *listController) renderContent(window *internal.Window, visibleItems []MenuItem, visibleIndices []int) {
 	renderer := window.Renderer
 
 	itemStartY := lc.StartY
@@ -728,24 +1303,20 @@ func (lc *listController) renderContent(window *internal.Window, visibleItems []
 
 	renderStatusBar(renderer, internal.Fonts.SmallFont, lc.Options.StatusBar, lc.Options.Margins)
 
+	itemStartY = lc.renderSearchStrip(renderer, itemStartY)
+
 	if len(lc.Options.Items) == 0 {
 		lc.renderEmptyMessage(renderer, internal.Fonts.MediumFont, itemStartY)
 	} else {
-		lc.renderItems(renderer, internal.Fonts.SmallFont, visibleItems, itemStartY)
+		lc.renderItems(renderer, internal.Fonts.SmallFont, visibleItems, visibleIndices, itemStartY)
 	}
 
 	if lc.imageIsDisplayed() {
 		lc.renderSelectedItemImage(renderer, lc.Options.Items[lc.Options.SelectedIndex].ImageFilename)
 	}
 
-	// Filter footer items: hide confirm button when multiselect is active with no selections
-	footerItems := lc.Options.FooterHelpItems
 	centerSingleItem := len(lc.Options.FooterHelpItems) == 1
-	if lc.MultiSelect && len(lc.SelectedItems) == 0 {
-		footerItems = lc.filterConfirmButton(lc.Options.FooterHelpItems)
-	}
-
-	renderFooter(renderer, internal.Fonts.SmallFont, footerItems, lc.Options.Margins.Bottom, true, centerSingleItem)
+	renderFooter(renderer, internal.Fonts.SmallFont, lc.footerItems(), lc.Options.Margins, true, centerSingleItem)
 }
 
 func (lc *listController) imageIsDisplayed() bool {
@@ -758,10 +1329,9 @@ func (lc *listController) imageIsDisplayed() bool {
 	return false
 }
 
-func (lc *listController) renderItems(renderer *sdl.Renderer, font *ttf.Font, visibleItems []MenuItem, startY int32) {
+func (lc *listController) renderItems(renderer *sdl.Renderer, font *ttf.Font, visibleItems []MenuItem, visibleIndices []int, startY int32) {
 	scaleFactor := internal.GetScaleFactor()
 
-	pillHeight := int32(float32(60) * scaleFactor)
 	pillPadding := int32(float32(40) * scaleFactor)
 
 	screenWidth, _, _ := renderer.GetOutputSize()
@@ -776,17 +1346,61 @@ func (lc *listController) renderItems(renderer *sdl.Renderer, font *ttf.Font, vi
 	}
 	maxTextWidth := maxPillWidth - pillPadding
 
+	if lc.Options.VerticalAlign == constants.VerticalAlignCenter && len(visibleItems) > 0 {
+		_, screenHeight, _ := renderer.GetOutputSize()
+		footerHeight := int32(float32(50)*scaleFactor*internal.GetFontScale()) + lc.Options.Margins.Bottom
+		availableHeight := screenHeight - footerHeight - startY
+		totalHeight := -lc.Options.ItemSpacing + lc.expandedHeight() +
+			lc.sectionHeadersHeight(lc.Options.VisibleStartIndex, len(visibleItems))
+		for _, item := range visibleItems {
+			totalHeight += lc.itemHeight(item) + lc.Options.ItemSpacing
+		}
+		if totalHeight < availableHeight {
+			startY += (availableHeight - totalHeight) / 2
+		}
+	}
+
+	itemY := startY
+	firstScrolled := true
 	for i, item := range visibleItems {
 		itemText := lc.formatItemText(item, lc.MultiSelect)
-		itemY := startY + int32(i)*(pillHeight+lc.Options.ItemSpacing)
-		globalIndex := lc.Options.VisibleStartIndex + i
+		globalIndex := visibleIndices[i]
+		pillHeight := lc.itemHeight(item)
+
+		// The badge's room is reserved up front so long text truncates or scrolls before reaching it
+		itemMaxTextWidth := maxTextWidth
+		badgeWidth := lc.badgeWidth(item)
+		if badgeWidth > 0 {
+			itemMaxTextWidth -= badgeWidth + lc.badgeGap()
+		}
+
+		// The first scrolled row always gets its section's header, so the header sticks while scrolling through the section
+		if !item.Pinned {
+			if section := lc.sectionOf(globalIndex); section >= 0 && (firstScrolled || lc.sectionStarts[section] == globalIndex) {
+				lc.renderSectionHeader(renderer, lc.sectionHeaders[section], itemY, maxPillWidth)
+				itemY += lc.sectionHeaderHeight() + lc.Options.ItemSpacing
+			}
+			firstScrolled = false
+		}
 
 		if item.Selected || item.Focused {
 			_, bgColor := lc.getItemColors(item)
-			pillWidth := internal.Min32(maxPillWidth, lc.measureText(font, itemText)+pillPadding)
+			textWidth := lc.measureText(font, itemText)
+			if item.Subtitle != "" {
+				textWidth = internal.Max32(textWidth, lc.measureText(internal.Fonts.SmallFont, item.Subtitle))
+			}
+			if badgeWidth > 0 {
+				textWidth = internal.Min32(textWidth, itemMaxTextWidth) + lc.badgeGap() + badgeWidth
+			}
+			pillWidth := internal.Min32(maxPillWidth, textWidth+pillPadding)
+
+			pillX := lc.Options.Margins.Left
+			if lc.Options.RTL {
+				pillX = screenWidth - lc.Options.Margins.Right - pillWidth
+			}
 
 			pillRect := sdl.Rect{
-				X: lc.Options.Margins.Left,
+				X: pillX,
 				Y: itemY,
 				W: pillWidth,
 				H: pillHeight,
@@ -794,25 +1408,282 @@ func (lc *listController) renderItems(renderer *sdl.Renderer, font *ttf.Font, vi
 			internal.DrawRoundedRect(renderer, &pillRect, int32(float32(30)*scaleFactor), bgColor)
 		}
 
-		lc.renderItemText(renderer, font, itemText, item.Focused, globalIndex, itemY, pillHeight, maxTextWidth)
+		if item.Subtitle == "" {
+			lc.renderItemText(renderer, font, itemText, item.Focused, globalIndex, itemY, pillHeight, itemMaxTextWidth)
+		} else {
+			// Text keeps its usual spot and the subtitle sits right below it, sharing the pill's padding
+			baseHeight := lc.baseItemHeight()
+			subtitleY := itemY + baseHeight - (baseHeight-int32(font.Height()))/2
+			lc.renderItemText(renderer, font, itemText, item.Focused, globalIndex, itemY, baseHeight, itemMaxTextWidth)
+			lc.renderSubtitle(renderer, item, globalIndex, subtitleY, itemMaxTextWidth)
+		}
+
+		if badgeWidth > 0 {
+			textWidth := internal.Min32(lc.measureText(font, itemText), itemMaxTextWidth)
+			badgeX := lc.itemTextX(renderer, textWidth) + textWidth + lc.badgeGap()
+			if lc.Options.RTL {
+				badgeX = lc.itemTextX(renderer, textWidth) - lc.badgeGap() - badgeWidth
+			}
+			lc.renderBadge(renderer, item.Badge, badgeX, itemY, lc.baseItemHeight(), badgeWidth)
+		}
+
+		itemY += pillHeight + lc.Options.ItemSpacing
+		if globalIndex == lc.expandedIndex {
+			lc.renderItemDetails(renderer, globalIndex, itemY, maxPillWidth)
+			itemY += lc.expandedHeight()
+		}
 	}
 }
 
-func (lc *listController) renderItemText(renderer *sdl.Renderer, font *ttf.Font, text string, focused bool, globalIndex int, itemY, pillHeight, maxWidth int32) {
-	textColor := lc.getTextColor(focused)
+// sectionOf returns the section the item at index belongs to, or -1 when the list has no sections.
+func (lc *listController) sectionOf(index int) int {
+	return sectionIndexIn(lc.sectionStarts, index)
+}
+
+func sectionIndexIn(starts []int, index int) int {
+	section := -1
+	for i, start := range starts {
+		if start > index {
+			break
+		}
+		section = i
+	}
+	return section
+}
+
+// openSearch lets the user edit the filter text with the keyboard and applies it on Enter.
+func (lc *listController) openSearch() {
+	opts := DefaultKeyboardOptions()
+	opts.HelpExitText = lc.Options.HelpExitText
+	opts.StatusBar = lc.Options.StatusBar
 
-	if focused && lc.shouldScroll(font, text, maxWidth) {
-		lc.renderScrollingText(renderer, font, text, textColor, globalIndex, itemY, pillHeight, maxWidth)
+	if kbResult, err := KeyboardWithOptions(lc.filterText, opts); err == nil {
+		lc.applyFilter(strings.TrimSpace(kbResult.Text))
+	}
+
+	// The keyboard consumed the releases for anything held when it opened
+	lc.heldDirections.up, lc.heldDirections.down = false, false
+	lc.heldDirections.left, lc.heldDirections.right = false, false
+	lc.lastInputTime = time.Now()
+}
+
+// applyFilter shows only the items whose text contains text, ignoring case. An empty text restores every item.
+// Focus stays on the same item when it survives the filter.
+func (lc *listController) applyFilter(text string) {
+	lc.syncFilteredItems()
+	focused := lc.originalIndex(lc.Options.SelectedIndex)
+
+	lc.filterText = text
+	lc.expandedIndex = -1
+	lc.ReorderMode = false
+	lc.itemScrollData = make(map[int]*internal.TextScrollData)
+	lc.subtitleData = make(map[int]*internal.TextScrollData)
+	lc.Options.SelectedIndex = 0
+	lc.Options.VisibleStartIndex = 0
+
+	if text == "" {
+		lc.Options.Items = lc.allItems
+		lc.filterMap = nil
+		lc.sectionStarts = lc.allSectionStarts
+		lc.sectionHeaders = lc.allSectionHeaders
+		if focused >= 0 && focused < len(lc.Options.Items) {
+			lc.Options.SelectedIndex = focused
+		}
 	} else {
-		truncatedText := lc.truncateText(font, text, maxWidth)
-		lc.renderStaticText(renderer, font, truncatedText, textColor, itemY, pillHeight)
+		query := strings.ToLower(text)
+		lc.Options.Items = nil
+		lc.filterMap = nil
+		lc.sectionStarts = nil
+		lc.sectionHeaders = nil
+
+		lastSection := -1
+		for i, item := range lc.allItems {
+			if !strings.Contains(strings.ToLower(item.Text), query) {
+				continue
+			}
+
+			if section := sectionIndexIn(lc.allSectionStarts, i); section >= 0 && section != lastSection {
+				lc.sectionStarts = append(lc.sectionStarts, len(lc.Options.Items))
+				lc.sectionHeaders = append(lc.sectionHeaders, lc.allSectionHeaders[section])
+				lastSection = section
+			}
+
+			if i == focused {
+				lc.Options.SelectedIndex = len(lc.Options.Items)
+			}
+			lc.filterMap = append(lc.filterMap, i)
+			lc.Options.Items = append(lc.Options.Items, item)
+		}
+	}
+
+	lc.SelectedItems = make(map[int]bool)
+	for i := range lc.Options.Items {
+		if lc.Options.Items[i].Selected {
+			lc.SelectedItems[i] = true
+		}
+	}
+	lc.updateSelectionState()
+
+	lc.Options.MaxVisibleItems = int(lc.calculateMaxVisibleItems(internal.GetWindow()))
+	lc.scrollTo(lc.Options.SelectedIndex)
+}
+
+// syncFilteredItems copies changes made to the filtered view (such as selections) back to the full item list.
+func (lc *listController) syncFilteredItems() {
+	for i, original := range lc.filterMap {
+		lc.allItems[original] = lc.Options.Items[i]
 	}
 }
 
-func (lc *listController) renderStaticText(renderer *sdl.Renderer, font *ttf.Font, text string, color sdl.Color, itemY, pillHeight int32) {
-	scaleFactor := internal.GetScaleFactor()
+// originalIndex maps an index in the visible, possibly filtered, items to its index in the full list.
+func (lc *listController) originalIndex(index int) int {
+	if lc.filterMap == nil || index < 0 || index >= len(lc.filterMap) {
+		return index
+	}
+	return lc.filterMap[index]
+}
+
+// finishFilter maps the result back to the unfiltered items before List returns.
+func (lc *listController) finishFilter(result *ListResult) {
+	lc.syncFilteredItems()
+
+	if lc.filterMap != nil {
+		if lc.MultiSelect && len(result.Selected) > 0 {
+			// Include selections hidden by the filter
+			result.Selected = result.Selected[:0]
+			for i, item := range lc.allItems {
+				if item.Selected && !item.NotMultiSelectable {
+					result.Selected = append(result.Selected, i)
+				}
+			}
+		} else {
+			for i := range result.Selected {
+				result.Selected[i] = lc.originalIndex(result.Selected[i])
+			}
+		}
+	}
+
+	result.Items = lc.allItems
+	result.FilterText = lc.filterText
+}
+
+func (lc *listController) searchStripHeight() int32 {
+	if lc.Options.SearchButton == constants.VirtualButtonUnassigned {
+		return 0
+	}
+	return int32(float32(40)*internal.GetScaleFactor()) + lc.Options.ItemSpacing + 10
+}
+
+// renderSearchStrip draws the current filter, or the placeholder, and returns where the items start below it.
+func (lc *listController) renderSearchStrip(renderer *sdl.Renderer, y int32) int32 {
+	if lc.Options.SearchButton == constants.VirtualButtonUnassigned {
+		return y
+	}
+
+	font := internal.Fonts.SmallFont
+	height := int32(float32(40) * internal.GetScaleFactor() * internal.GetFontScale())
+	textPadding := int32(float32(20) * internal.GetScaleFactor())
+	screenWidth, _, _ := renderer.GetOutputSize()
+	width := screenWidth - lc.Options.Margins.Left - lc.Options.Margins.Right
+
+	stripRect := sdl.Rect{X: lc.Options.Margins.Left, Y: y, W: width, H: height}
+	internal.DrawRoundedRect(renderer, &stripRect, height/2, sdl.Color{R: 50, G: 50, B: 50, A: 255})
+
+	text, color := lc.filterText, internal.GetTheme().TextColor
+	if text == "" {
+		text, color = lc.Options.SearchPlaceholder, internal.GetTheme().HintColor
+	}
+
+	if text != "" {
+		surface, _ := font.RenderUTF8Blended(lc.truncateText(font, text, width-textPadding*2), color)
+		if surface != nil {
+			texture, _ := renderer.CreateTextureFromSurface(surface)
+			if texture != nil {
+				x := stripRect.X + textPadding
+				if lc.Options.RTL {
+					x = stripRect.X + stripRect.W - textPadding - surface.W
+				}
+				renderer.Copy(texture, nil, &sdl.Rect{X: x, Y: y + (height-surface.H)/2, W: surface.W, H: surface.H})
+				texture.Destroy()
+			}
+			surface.Free()
+		}
+	}
+
+	return y + lc.searchStripHeight()
+}
+
+func (lc *listController) sectionHeaderHeight() int32 {
+	return int32(float32(40) * internal.GetScaleFactor())
+}
+
+// sectionHeadersHeight returns the height taken by the headers drawn above count items starting at start.
+func (lc *listController) sectionHeadersHeight(start, count int) int32 {
+	if len(lc.sectionStarts) == 0 || count <= 0 {
+		return 0
+	}
+
+	headers := int32(1)
+	for _, s := range lc.sectionStarts {
+		if s > start && s < start+count {
+			headers++
+		}
+	}
+	return headers * (lc.sectionHeaderHeight() + lc.Options.ItemSpacing)
+}
+
+// visibleItemCount returns how many items starting at start fit on screen once section headers, pinned items and subtitles take their share.
+// Pinned items inside the window are counted too, even though they are drawn at the top instead.
+func (lc *listController) visibleItemCount(start int) int {
+	if len(lc.sectionStarts) == 0 && lc.pinnedCount() == 0 && !lc.hasSubtitles() {
+		return lc.Options.MaxVisibleItems
+	}
+
+	headerHeight := lc.sectionHeaderHeight() + lc.Options.ItemSpacing
+
+	budget := int32(lc.Options.MaxVisibleItems) * (lc.baseItemHeight() + lc.Options.ItemSpacing)
+	for _, item := range lc.Options.Items {
+		if item.Pinned {
+			budget -= lc.itemHeight(item) + lc.Options.ItemSpacing
+		}
+	}
+	if len(lc.sectionStarts) > 0 {
+		// The first scrolled row always carries a header
+		budget -= headerHeight
+	}
+
+	count, shown := 0, 0
+	for i := start; i < len(lc.Options.Items); i++ {
+		if lc.Options.Items[i].Pinned {
+			count++
+			continue
+		}
+
+		need := lc.itemHeight(lc.Options.Items[i]) + lc.Options.ItemSpacing
+		if shown > 0 && slices.Contains(lc.sectionStarts, i) {
+			need += headerHeight
+		}
+		// Always leave room for at least one scrolled row
+		if budget < need && shown > 0 {
+			break
+		}
+		budget -= need
+		count++
+		shown++
+	}
+	return max(count, 1)
+}
+
+func (lc *listController) renderSectionHeader(renderer *sdl.Renderer, header string, y, maxWidth int32) {
+	if header == "" {
+		return
+	}
 
-	surface, _ := font.RenderUTF8Blended(text, color)
+	font := internal.Fonts.TinyFont
+	height := lc.sectionHeaderHeight()
+	textPadding := int32(float32(20) * internal.GetScaleFactor())
+
+	surface, _ := font.RenderUTF8Blended(lc.truncateText(font, header, maxWidth-textPadding*2), internal.GetTheme().HintColor)
 	if surface == nil {
 		return
 	}
@@ -824,22 +1695,99 @@ func (lc *listController) renderStaticText(renderer *sdl.Renderer, font *ttf.Fon
 	}
 	defer texture.Destroy()
 
-	textPadding := int32(float32(20) * scaleFactor)
-	destRect := sdl.Rect{
-		X: lc.Options.Margins.Left + textPadding,
-		Y: itemY + (pillHeight-surface.H)/2,
+	pillWidth := surface.W + textPadding*2
+	pillX := lc.Options.Margins.Left
+	if lc.Options.RTL {
+		screenWidth, _, _ := renderer.GetOutputSize()
+		pillX = screenWidth - lc.Options.Margins.Right - pillWidth
+	}
+
+	pillRect := sdl.Rect{X: pillX, Y: y, W: pillWidth, H: height}
+	internal.DrawRoundedRect(renderer, &pillRect, height/2, internal.GetTheme().AccentColor)
+
+	renderer.Copy(texture, nil, &sdl.Rect{
+		X: pillX + textPadding,
+		Y: y + (height-surface.H)/2,
 		W: surface.W,
 		H: surface.H,
+	})
+}
+
+func (lc *listController) renderItemDetails(renderer *sdl.Renderer, index int, y, maxWidth int32) {
+	font := internal.Fonts.TinyFont
+	padding := int32(float32(10) * internal.GetScaleFactor())
+	lineHeight := int32(font.Height())
+	textPadding := int32(float32(20) * internal.GetScaleFactor())
+	color := sdl.Color{R: 180, G: 180, B: 180, A: 255}
+
+	for i, line := range lc.detailLines(index) {
+		if line == "" {
+			continue
+		}
+
+		surface, _ := font.RenderUTF8Blended(lc.truncateText(font, line, maxWidth-textPadding), color)
+		if surface == nil {
+			continue
+		}
+
+		texture, _ := renderer.CreateTextureFromSurface(surface)
+		if texture == nil {
+			surface.Free()
+			continue
+		}
+
+		renderer.Copy(texture, nil, &sdl.Rect{
+			X: lc.itemTextX(renderer, surface.W),
+			Y: y + padding + int32(i)*lineHeight,
+			W: surface.W,
+			H: surface.H,
+		})
+		texture.Destroy()
+		surface.Free()
 	}
+}
 
-	renderer.Copy(texture, nil, &destRect)
+// baseItemHeight is the height of an item row without a subtitle.
+func (lc *listController) baseItemHeight() int32 {
+	return int32(float32(60) * internal.GetScaleFactor() * internal.GetFontScale())
 }
 
-func (lc *listController) renderScrollingText(renderer *sdl.Renderer, font *ttf.Font, text string, color sdl.Color, globalIndex int, itemY, pillHeight, maxWidth int32) {
-	scaleFactor := internal.GetScaleFactor()
-	scrollData := lc.getOrCreateScrollData(globalIndex, text, font, maxWidth)
+// itemHeight returns the pill height of item, which grows by a line when it has a subtitle.
+func (lc *listController) itemHeight(item MenuItem) int32 {
+	if item.Subtitle == "" {
+		return lc.baseItemHeight()
+	}
+	return lc.baseItemHeight() + int32(internal.Fonts.SmallFont.Height())
+}
+
+// badgeWidth returns the width of item's badge pill, or 0 when it has none.
+func (lc *listController) badgeWidth(item MenuItem) int32 {
+	if item.Badge == "" {
+		return 0
+	}
+	padding := int32(float32(10) * internal.GetScaleFactor())
+	// Short badges like "3" stay round
+	return internal.Max32(lc.measureText(internal.Fonts.TinyFont, item.Badge)+padding*2, lc.badgeHeight())
+}
 
-	surface, _ := font.RenderUTF8Blended(text, color)
+func (lc *listController) badgeHeight() int32 {
+	return int32(float32(30) * internal.GetScaleFactor())
+}
+
+func (lc *listController) badgeGap() int32 {
+	return int32(float32(10) * internal.GetScaleFactor())
+}
+
+// renderBadge draws a badge pill of the given width, vertically centered in the row starting at rowY.
+func (lc *listController) renderBadge(renderer *sdl.Renderer, badge string, x, rowY, rowHeight, width int32) {
+	font := internal.Fonts.TinyFont
+	height := lc.badgeHeight()
+	y := rowY + (rowHeight-height)/2
+
+	badgeRect := sdl.Rect{X: x, Y: y, W: width, H: height}
+	internal.DrawRoundedRect(renderer, &badgeRect, height/2, internal.GetTheme().AccentColor)
+
+	surface, _ := font.RenderUTF8Blended(badge, internal.GetTheme().TextColor)
 	if surface == nil {
 		return
 	}
@@ -851,24 +1799,107 @@ func (lc *listController) renderScrollingText(renderer *sdl.Renderer, font *ttf.
 	}
 	defer texture.Destroy()
 
+	renderer.Copy(texture, nil, &sdl.Rect{
+		X: x + (width-surface.W)/2,
+		Y: y + (height-surface.H)/2,
+		W: surface.W,
+		H: surface.H,
+	})
+}
+
+func (lc *listController) hasSubtitles() bool {
+	for _, item := range lc.Options.Items {
+		if item.Subtitle != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// renderSubtitle draws the subtitle line of an item, truncating or scrolling it independently of the main text.
+func (lc *listController) renderSubtitle(renderer *sdl.Renderer, item MenuItem, globalIndex int, y, maxWidth int32) {
+	font := internal.Fonts.SmallFont
+	lineHeight := int32(font.Height())
+
+	color := item.SubtitleColor
+	if color == (sdl.Color{}) {
+		color = internal.GetTheme().HintColor
+		if item.Focused {
+			color = lc.getTextColor(true)
+		}
+	}
+
+	if lc.scrollsOverflow(item.Focused) && lc.shouldScroll(font, item.Subtitle, maxWidth) {
+		lc.renderScrollingText(renderer, font, item.Subtitle, color, lc.subtitleData, globalIndex, y, lineHeight, maxWidth)
+	} else {
+		lc.renderStaticText(renderer, font, lc.truncateText(font, item.Subtitle, maxWidth), color, y, lineHeight)
+	}
+}
+
+func (lc *listController) renderItemText(renderer *sdl.Renderer, font *ttf.Font, text string, focused bool, globalIndex int, itemY, pillHeight, maxWidth int32) {
+	textColor := lc.getTextColor(focused)
+
+	if lc.scrollsOverflow(focused) && lc.shouldScroll(font, text, maxWidth) {
+		lc.renderScrollingText(renderer, font, text, textColor, lc.itemScrollData, globalIndex, itemY, pillHeight, maxWidth)
+	} else {
+		truncatedText := lc.truncateText(font, text, maxWidth)
+		lc.renderStaticText(renderer, font, truncatedText, textColor, itemY, pillHeight)
+	}
+}
+
+func (lc *listController) renderStaticText(renderer *sdl.Renderer, font *ttf.Font, text string, color sdl.Color, itemY, pillHeight int32) {
+	texture := internal.Glyphs.Texture(renderer, font, text, color)
+	if texture == nil {
+		return
+	}
+	_, _, w, h, _ := texture.Query()
+
+	destRect := sdl.Rect{
+		X: lc.itemTextX(renderer, w),
+		Y: itemY + (pillHeight-h)/2,
+		W: w,
+		H: h,
+	}
+
+	renderer.Copy(texture, nil, &destRect)
+}
+
+func (lc *listController) renderScrollingText(renderer *sdl.Renderer, font *ttf.Font, text string, color sdl.Color, scrollData map[int]*internal.TextScrollData, globalIndex int, itemY, pillHeight, maxWidth int32) {
+	data := lc.getOrCreateScrollData(scrollData, globalIndex, text, font, maxWidth)
+
+	texture := internal.Glyphs.Texture(renderer, font, text, color)
+	if texture == nil {
+		return
+	}
+	_, _, w, h, _ := texture.Query()
+
 	clipRect := &sdl.Rect{
-		X: scrollData.ScrollOffset,
+		X: data.ScrollOffset,
 		Y: 0,
-		W: internal.Min32(maxWidth, surface.W-scrollData.ScrollOffset),
-		H: surface.H,
+		W: internal.Min32(maxWidth, w-data.ScrollOffset),
+		H: h,
 	}
 
-	textPadding := int32(float32(20) * scaleFactor)
 	destRect := sdl.Rect{
-		X: lc.Options.Margins.Left + textPadding,
-		Y: itemY + (pillHeight-surface.H)/2,
+		X: lc.itemTextX(renderer, clipRect.W),
+		Y: itemY + (pillHeight-h)/2,
 		W: clipRect.W,
-		H: surface.H,
+		H: h,
 	}
 
 	renderer.Copy(texture, clipRect, &destRect)
 }
 
+// itemTextX returns where item text of the given width starts, honoring RTL layout.
+func (lc *listController) itemTextX(renderer *sdl.Renderer, width int32) int32 {
+	textPadding := int32(float32(20) * internal.GetScaleFactor())
+	if lc.Options.RTL {
+		screenWidth, _, _ := renderer.GetOutputSize()
+		return screenWidth - lc.Options.Margins.Right - textPadding - width
+	}
+	return lc.Options.Margins.Left + textPadding
+}
+
 func (lc *listController) renderEmptyMessage(renderer *sdl.Renderer, font *ttf.Font, startY int32) {
 	normalized := strings.ReplaceAll(strings.ReplaceAll(lc.Options.EmptyMessage, "\r\n", "\n"), "\r", "\n")
 	lines := strings.Split(normalized, "\n")
@@ -924,6 +1955,12 @@ func (lc *listController) renderSelectedItemBackground(window *internal.Window,
 func (lc *listController) renderSelectedItemImage(renderer *sdl.Renderer, imageFilename string) {
 	cacheKey := "img:" + imageFilename
 	texture := lc.textureCache.Get(cacheKey)
+	if texture == nil && lc.Options.EnableAsyncImageLoading {
+		if lc.loadImageAsync(imageFilename) {
+			lc.renderImagePlaceholder(renderer)
+		}
+		return
+	}
 	if texture == nil {
 		var err error
 		texture, err = img.LoadTexture(renderer, imageFilename)
@@ -934,10 +1971,17 @@ func (lc *listController) renderSelectedItemImage(renderer *sdl.Renderer, imageF
 	}
 
 	_, _, textureWidth, textureHeight, _ := texture.Query()
+	if destRect, ok := lc.selectedImageRect(renderer, textureWidth, textureHeight); ok {
+		renderer.Copy(texture, nil, &destRect)
+	}
+}
+
+// selectedImageRect fits an image of the given size into the area beside the list.
+func (lc *listController) selectedImageRect(renderer *sdl.Renderer, textureWidth, textureHeight int32) (sdl.Rect, bool) {
 	screenWidth, screenHeight, _ := renderer.GetOutputSize()
 
 	if textureWidth == 0 || textureHeight == 0 {
-		return
+		return sdl.Rect{}, false
 	}
 
 	maxImageWidth := screenWidth / 3
@@ -957,17 +2001,87 @@ func (lc *listController) renderSelectedItemImage(renderer *sdl.Renderer, imageF
 
 	// Ensure we have valid dimensions after scaling
 	if imageWidth <= 0 || imageHeight <= 0 {
-		return
+		return sdl.Rect{}, false
 	}
 
-	destRect := sdl.Rect{
-		X: screenWidth - imageWidth - 20,
+	imageX := screenWidth - imageWidth - 20
+	if lc.Options.RTL {
+		imageX = 20
+	}
+
+	return sdl.Rect{
+		X: imageX,
 		Y: (screenHeight - imageHeight) / 2,
 		W: imageWidth,
 		H: imageHeight,
+	}, true
+}
+
+// loadedImage is an image decoded off the render thread, waiting to become a texture.
+type loadedImage struct {
+	filename string
+	surface  *sdl.Surface
+}
+
+// loadImageAsync starts decoding imageFilename in the background unless it is already loading.
+// Returns false if an earlier attempt failed, so no placeholder is drawn for it.
+func (lc *listController) loadImageAsync(imageFilename string) bool {
+	if loading, seen := lc.imageLoads[imageFilename]; seen {
+		return loading
 	}
+	lc.imageLoads[imageFilename] = true
 
-	renderer.Copy(texture, nil, &destRect)
+	lc.imageLoaders.Add(1)
+	go func() {
+		defer lc.imageLoaders.Done()
+
+		surface, err := img.Load(imageFilename)
+		if err != nil {
+			surface = nil
+		}
+
+		// Wait for the render thread to take the image, so imageLoads is always resolved while the list is open
+		select {
+		case lc.loadedImages <- loadedImage{filename: imageFilename, surface: surface}:
+		case <-lc.imageLoadsDone:
+			if surface != nil {
+				surface.Free()
+			}
+		}
+	}()
+	return true
+}
+
+// receiveLoadedImages turns images decoded since the last frame into cached textures.
+func (lc *listController) receiveLoadedImages(renderer *sdl.Renderer) {
+	for {
+		select {
+		case loaded := <-lc.loadedImages:
+			if loaded.surface == nil {
+				lc.imageLoads[loaded.filename] = false
+				continue
+			}
+
+			texture, err := renderer.CreateTextureFromSurface(loaded.surface)
+			loaded.surface.Free()
+			if err != nil {
+				lc.imageLoads[loaded.filename] = false
+				continue
+			}
+
+			delete(lc.imageLoads, loaded.filename)
+			lc.textureCache.Set("img:"+loaded.filename, texture)
+		default:
+			return
+		}
+	}
+}
+
+// renderImagePlaceholder draws a grey square where the selected item's image will appear.
+func (lc *listController) renderImagePlaceholder(renderer *sdl.Renderer) {
+	if rect, ok := lc.selectedImageRect(renderer, 1, 1); ok {
+		internal.DrawRoundedRect(renderer, &rect, int32(float32(10)*internal.GetScaleFactor()), sdl.Color{R: 60, G: 60, B: 60, A: 255})
+	}
 }
 
 func (lc *listController) renderScrollableTitle(renderer *sdl.Renderer, font *ttf.Font, title string, align constants.TextAlign, startY, marginLeft, statusBarWidth int32) int32 {
@@ -1027,16 +2141,24 @@ func (lc *listController) renderScrollingTitle(renderer *sdl.Renderer, texture *
 }
 
 func (lc *listController) updateScrolling() {
+	// Long text stays at its start instead of scrolling back and forth
+	if internal.ReducedMotion() {
+		return
+	}
+
 	currentTime := time.Now()
 
 	if lc.titleScrollData.NeedsScrolling {
 		lc.updateScrollData(lc.titleScrollData, currentTime)
 	}
 
-	for idx := lc.Options.VisibleStartIndex; idx < min(lc.Options.VisibleStartIndex+lc.Options.MaxVisibleItems, len(lc.Options.Items)); idx++ {
+	for _, idx := range lc.visibleIndices() {
 		if scrollData, exists := lc.itemScrollData[idx]; exists && scrollData.NeedsScrolling {
 			lc.updateScrollData(scrollData, currentTime)
 		}
+		if scrollData, exists := lc.subtitleData[idx]; exists && scrollData.NeedsScrolling {
+			lc.updateScrollData(scrollData, currentTime)
+		}
 	}
 }
 
@@ -1066,8 +2188,8 @@ func (lc *listController) updateScrollData(data *internal.TextScrollData, curren
 	}
 }
 
-func (lc *listController) getOrCreateScrollData(index int, text string, font *ttf.Font, maxWidth int32) *internal.TextScrollData {
-	data, exists := lc.itemScrollData[index]
+func (lc *listController) getOrCreateScrollData(scrollData map[int]*internal.TextScrollData, index int, text string, font *ttf.Font, maxWidth int32) *internal.TextScrollData {
+	data, exists := scrollData[index]
 	if !exists {
 		surface, _ := font.RenderUTF8Blended(text, sdl.Color{R: 255, G: 255, B: 255, A: 255})
 		if surface == nil {
@@ -1081,11 +2203,23 @@ func (lc *listController) getOrCreateScrollData(index int, text string, font *tt
 			ContainerWidth: maxWidth,
 			Direction:      1,
 		}
-		lc.itemScrollData[index] = data
+		scrollData[index] = data
 	}
 	return data
 }
 
+// scrollsOverflow reports whether overflowing item text should marquee instead of truncate.
+func (lc *listController) scrollsOverflow(focused bool) bool {
+	switch lc.Options.TextOverflow {
+	case constants.TextOverflowTruncate:
+		return false
+	case constants.TextOverflowScroll:
+		return true
+	default:
+		return focused
+	}
+}
+
 func (lc *listController) shouldScroll(font *ttf.Font, text string, maxWidth int32) bool {
 	surface, _ := font.RenderUTF8Blended(text, sdl.Color{R: 255, G: 255, B: 255, A: 255})
 	if surface == nil {
@@ -1098,27 +2232,32 @@ func (lc *listController) shouldScroll(font *ttf.Font, text string, maxWidth int
 func (lc *listController) calculateMaxVisibleItems(window *internal.Window) int32 {
 	scaleFactor := internal.GetScaleFactor()
 
-	pillHeight := int32(float32(60) * scaleFactor)
+	pillHeight := int32(float32(60) * scaleFactor * internal.GetFontScale())
 
 	_, screenHeight, _ := window.Renderer.GetOutputSize()
 
 	var titleHeight int32 = 0
 	if lc.Options.Title != "" {
 		if lc.Options.SmallTitle {
-			titleHeight = int32(float32(50) * scaleFactor)
+			titleHeight = int32(float32(50) * scaleFactor * internal.GetFontScale())
 		} else {
-			titleHeight = int32(float32(60) * scaleFactor)
+			titleHeight = int32(float32(60) * scaleFactor * internal.GetFontScale())
 		}
 		titleHeight += lc.Options.TitleSpacing
 	}
 
-	footerHeight := int32(float32(50) * scaleFactor)
+	footerHeight := int32(float32(50) * scaleFactor * internal.GetFontScale())
 
-	availableHeight := screenHeight - titleHeight - footerHeight - (lc.StartY * 2)
+	availableHeight := screenHeight - titleHeight - footerHeight - (lc.StartY * 2) - lc.searchStripHeight()
 
 	itemHeightWithSpacing := pillHeight + lc.Options.ItemSpacing
 	maxItems := availableHeight/itemHeightWithSpacing - 1
 
+	// An expanded item pushes the rest down, so give up as many rows as its details cover
+	if extra := lc.expandedHeight(); extra > 0 {
+		maxItems -= (extra + itemHeightWithSpacing - 1) / itemHeightWithSpacing
+	}
+
 	if maxItems < 1 {
 		maxItems = 1
 	}
@@ -1156,6 +2295,12 @@ func (lc *listController) formatItemText(item MenuItem, multiSelect bool) string
 	if !multiSelect || item.NotMultiSelectable {
 		return item.Text
 	}
+	if lc.Options.RTL {
+		if item.Selected {
+			return item.Text + " ☑"
+		}
+		return item.Text + " ☐"
+	}
 	if item.Selected {
 		return "☑ " + item.Text
 	}
@@ -1180,12 +2325,30 @@ func (lc *listController) getTextColor(focused bool) sdl.Color {
 	return internal.GetTheme().TextColor
 }
 
-func (lc *listController) filterConfirmButton(items []FooterHelpItem) []FooterHelpItem {
-	filtered := make([]FooterHelpItem, 0, len(items))
-	for _, item := range items {
-		if !item.IsConfirmButton {
-			filtered = append(filtered, item)
+// footerItems returns the footer items for the current state.
+// The confirm button is disabled while multiselect is active with no selections,
+// and a hint for SelectAllButton, when set, is added after the caller's items.
+func (lc *listController) footerItems() []FooterHelpItem {
+	if !lc.MultiSelect {
+		return lc.Options.FooterHelpItems
+	}
+
+	items := make([]FooterHelpItem, len(lc.Options.FooterHelpItems), len(lc.Options.FooterHelpItems)+1)
+	copy(items, lc.Options.FooterHelpItems)
+
+	for i := range items {
+		if items[i].IsConfirmButton && len(lc.SelectedItems) == 0 {
+			items[i].Disabled = true
+		}
+	}
+
+	if lc.Options.SelectAllButton != constants.VirtualButtonUnassigned && len(lc.Options.Items) > 0 {
+		// The select all button toggles, so its hint follows the current state
+		selectAllLabel := "Select All"
+		if lc.allSelected() {
+			selectAllLabel = "Deselect All"
 		}
+		items = append(items, FooterHelpItem{ButtonName: lc.Options.SelectAllButton.GetName(), HelpText: selectAllLabel})
 	}
-	return filtered
+	return items
 }
@@ -0,0 +1,146 @@
+package gabagool
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+)
+
+func testMenuItems(count int) []MenuItem {
+	items := make([]MenuItem, count)
+	for i := range items {
+		items[i] = MenuItem{Text: fmt.Sprintf("Item %d", i)}
+	}
+	return items
+}
+
+func TestListSelectsItemFromInjectedInput(t *testing.T) {
+	processor := internal.GetInputProcessor()
+	for i := 0; i < 3; i++ {
+		if err := processor.InjectButtonPress(constants.VirtualButtonDown); err != nil {
+			t.Fatal(err)
+		}
+		if err := processor.InjectButtonRelease(constants.VirtualButtonDown); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := processor.InjectButtonPress(constants.VirtualButtonA); err != nil {
+		t.Fatal(err)
+	}
+
+	options := DefaultListOptions("Test", testMenuItems(6))
+	options.InputDelay = 0
+
+	result, err := List(options)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+
+	if result.Action != ListActionSelected {
+		t.Errorf("Action = %v, want ListActionSelected", result.Action)
+	}
+	if len(result.Selected) != 1 || result.Selected[0] != 3 {
+		t.Errorf("Selected = %v, want [3]", result.Selected)
+	}
+}
+
+func TestListSectionsFlattenIntoItems(t *testing.T) {
+	options := DefaultListOptions("Test", nil)
+	options.Sections = []ListSection{
+		{Header: "Fruit", Items: []MenuItem{{Text: "Apple"}, {Text: "Banana"}}},
+		{Header: "Empty"},
+		{Header: "Vegetables", Items: []MenuItem{{Text: "Carrot"}}},
+	}
+
+	lc := newListController(options)
+	defer lc.cleanup()
+
+	if len(lc.Options.Items) != 3 {
+		t.Fatalf("len(Items) = %d, want 3", len(lc.Options.Items))
+	}
+	if !slices.Equal(lc.sectionStarts, []int{0, 2}) {
+		t.Errorf("sectionStarts = %v, want [0 2]", lc.sectionStarts)
+	}
+	if !slices.Equal(lc.sectionHeaders, []string{"Fruit", "Vegetables"}) {
+		t.Errorf("sectionHeaders = %v, want [Fruit Vegetables]", lc.sectionHeaders)
+	}
+}
+
+func TestListFilterKeepsFocusAndMapsIndices(t *testing.T) {
+	items := []MenuItem{{Text: "Apple"}, {Text: "Banana"}, {Text: "Cherry"}, {Text: "Apricot"}}
+	lc := newListController(DefaultListOptions("Test", items))
+	defer lc.cleanup()
+	lc.Options.SelectedIndex = 3
+
+	lc.applyFilter("AP")
+
+	if len(lc.Options.Items) != 2 || lc.Options.Items[0].Text != "Apple" || lc.Options.Items[1].Text != "Apricot" {
+		t.Fatalf("filtered Items = %v, want Apple and Apricot", lc.Options.Items)
+	}
+	if lc.Options.SelectedIndex != 1 {
+		t.Errorf("SelectedIndex = %d, want 1 (Apricot)", lc.Options.SelectedIndex)
+	}
+	if got := lc.originalIndex(1); got != 3 {
+		t.Errorf("originalIndex(1) = %d, want 3", got)
+	}
+
+	lc.applyFilter("")
+
+	if len(lc.Options.Items) != 4 {
+		t.Fatalf("len(Items) = %d after clearing the filter, want 4", len(lc.Options.Items))
+	}
+	if lc.Options.SelectedIndex != 3 {
+		t.Errorf("SelectedIndex = %d after clearing the filter, want 3", lc.Options.SelectedIndex)
+	}
+}
+
+func TestListFilterKeepsMatchingSectionHeaders(t *testing.T) {
+	options := DefaultListOptions("Test", nil)
+	options.Sections = []ListSection{
+		{Header: "A", Items: []MenuItem{{Text: "Apple"}, {Text: "Banana"}}},
+		{Header: "B", Items: []MenuItem{{Text: "Apricot"}, {Text: "Cherry"}}},
+	}
+	lc := newListController(options)
+	defer lc.cleanup()
+
+	lc.applyFilter("cherry")
+
+	if !slices.Equal(lc.sectionStarts, []int{0}) || !slices.Equal(lc.sectionHeaders, []string{"B"}) {
+		t.Errorf("sections = %v %v, want [0] [B]", lc.sectionStarts, lc.sectionHeaders)
+	}
+
+	lc.applyFilter("ap")
+
+	if !slices.Equal(lc.sectionStarts, []int{0, 1}) || !slices.Equal(lc.sectionHeaders, []string{"A", "B"}) {
+		t.Errorf("sections = %v %v, want [0 1] [A B]", lc.sectionStarts, lc.sectionHeaders)
+	}
+}
+
+func TestListPinnedItemsStayOnTop(t *testing.T) {
+	items := testMenuItems(20)
+	items[0].Pinned = true
+	lc := newListController(DefaultListOptions("Test", items))
+	defer lc.cleanup()
+
+	if got := lc.pinnedCount(); got != 1 {
+		t.Errorf("pinnedCount() = %d, want 1", got)
+	}
+	if got := lc.skipPinned(0, 1); got != 1 {
+		t.Errorf("skipPinned(0, 1) = %d, want 1", got)
+	}
+
+	lc.Options.VisibleStartIndex = 10
+	if indices := lc.visibleIndices(); len(indices) == 0 || indices[0] != 0 {
+		t.Errorf("visibleIndices() = %v, want the pinned item first", indices)
+	}
+
+	// Wrapping past the end lands on the first scrolling item, not the pinned one
+	lc.Options.SelectedIndex = len(items) - 1
+	lc.moveSelection(1)
+	if lc.Options.SelectedIndex != 1 {
+		t.Errorf("SelectedIndex = %d after wrapping, want 1", lc.Options.SelectedIndex)
+	}
+}
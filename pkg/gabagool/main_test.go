@@ -0,0 +1,20 @@
+package gabagool
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// TestMain runs every test against the headless test mode window, so components and their controllers
+// can lay out and render without a display.
+func TestMain(m *testing.M) {
+	if err := UseTestMode(); err != nil {
+		fmt.Fprintln(os.Stderr, "failed to start test mode:", err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+	Close()
+	os.Exit(code)
+}
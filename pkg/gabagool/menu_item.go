@@ -1,7 +1,12 @@
 package gabagool
 
+import "github.com/veandco/go-sdl2/sdl"
+
 type MenuItem struct {
 	Text               string
+	Subtitle           string    // Secondary line shown below Text in a smaller font
+	SubtitleColor      sdl.Color // Color of Subtitle; the theme's hint color when unset
+	Badge              string    // Short label drawn in a pill after Text, like a notification count ("12", "NEW")
 	Selected           bool
 	Focused            bool
 	NotMultiSelectable bool
@@ -9,6 +14,14 @@ type MenuItem struct {
 	Metadata           interface{}
 	ImageFilename      string
 	BackgroundFilename string
+	Details            string // Extra lines shown inline when the item is expanded (see ListOptions.ExpandableItems)
+	Pinned             bool   // Always shown at the top of the list, such as "New Game" or "Back", however far it is scrolled
+}
+
+// ListSection groups items under a header in a List. See ListOptions.Sections.
+type ListSection struct {
+	Header string
+	Items  []MenuItem
 }
 
 // ListResult is the standardized return type for the List component
@@ -17,4 +30,18 @@ type ListResult struct {
 	Selected        []int      // Indices of selected items (always a slice, even for single selection)
 	Action          ListAction // The action taken when exiting (Selected or Triggered)
 	VisiblePosition int        // Position of first selected item relative to VisibleStartIndex (for scroll restoration)
+	State           *ListState // View state on exit; pass it back via ListOptions.State to restore the list
+	FilterText      string     // The search filter active on exit, empty when the list was unfiltered
+}
+
+// ListState bundles the view state of a List (focus, scroll position, search filter, multiselect selections and the expanded item).
+// It is opaque: take it from ListResult.State and hand it back through ListOptions.State unchanged.
+// Indices that no longer fit the item list are clamped or dropped on restore.
+type ListState struct {
+	selectedIndex     int
+	visibleStartIndex int
+	multiSelect       bool
+	selected          []int
+	expandedIndex     int
+	filterText        string
 }
@@ -0,0 +1,27 @@
+package gabagool
+
+import "github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+
+// InputEvent is a virtual button press or release, as seen by input middleware.
+type InputEvent = internal.Event
+
+// AddInputMiddleware runs fn on every input event before components see it, in the order added.
+// fn may return the event unchanged, modify it, replace it, or return nil to drop it.
+// The returned function removes the middleware again.
+//
+// Example, swapping A and B on one screen:
+//
+//	swap := func(event *gabagool.InputEvent) *gabagool.InputEvent {
+//		switch event.Button {
+//		case constants.VirtualButtonA:
+//			event.Button = constants.VirtualButtonB
+//		case constants.VirtualButtonB:
+//			event.Button = constants.VirtualButtonA
+//		}
+//		return event
+//	}
+//	remove := gabagool.AddInputMiddleware(swap)
+//	defer remove()
+func AddInputMiddleware(fn func(event *InputEvent) *InputEvent) (remove func()) {
+	return internal.GetInputProcessor().AddMiddleware(fn)
+}
@@ -0,0 +1,60 @@
+package gabagool
+
+import "errors"
+
+// ScreenFunc shows one screen, such as a List or DetailScreen, and returns when the user leaves it.
+type ScreenFunc func() error
+
+// Navigator runs screens as a back-stack, so callers don't have to track where "back" goes.
+// The screen on top of the stack runs until it returns:
+//   - ErrCancelled pops it, and anything it pushed, going back to the screen below.
+//   - nil runs whatever is now on top: a screen it pushed, the screen below if it popped itself, or itself again.
+//   - any other error stops Run and is returned.
+//
+// Run returns nil once the stack is empty.
+type Navigator struct {
+	stack []ScreenFunc
+}
+
+// NewNavigator creates a Navigator with root at the bottom of its stack.
+func NewNavigator(root ScreenFunc) *Navigator {
+	return &Navigator{stack: []ScreenFunc{root}}
+}
+
+// Push puts screen on top of the stack. It runs once the current screen returns.
+func (n *Navigator) Push(screen ScreenFunc) {
+	n.stack = append(n.stack, screen)
+}
+
+// Pop removes the screen on top of the stack, or returns ErrNavigatorEmpty if there is none.
+func (n *Navigator) Pop() error {
+	if len(n.stack) == 0 {
+		return ErrNavigatorEmpty
+	}
+	n.stack = n.stack[:len(n.stack)-1]
+	return nil
+}
+
+// Depth returns how many screens are on the stack.
+func (n *Navigator) Depth() int {
+	return len(n.stack)
+}
+
+// Run shows screens until the stack is empty or a screen returns an error other than ErrCancelled.
+func (n *Navigator) Run() error {
+	for len(n.stack) > 0 {
+		depth := len(n.stack)
+		screen := n.stack[depth-1]
+
+		err := screen()
+		if errors.Is(err, ErrCancelled) {
+			// Drop the screen along with anything it pushed before backing out
+			n.stack = n.stack[:min(len(n.stack), depth-1)]
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
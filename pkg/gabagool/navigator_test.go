@@ -0,0 +1,88 @@
+package gabagool
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNavigatorCancelPopsScreenAndEverythingItPushed(t *testing.T) {
+	var visited []string
+	var nav *Navigator
+
+	rootRuns := 0
+	root := func() error {
+		visited = append(visited, "root")
+		rootRuns++
+		if rootRuns == 1 {
+			nav.Push(func() error {
+				visited = append(visited, "child")
+				nav.Push(func() error {
+					visited = append(visited, "never")
+					return nil
+				})
+				return ErrCancelled
+			})
+			return nil
+		}
+		return ErrCancelled
+	}
+	nav = NewNavigator(root)
+
+	if err := nav.Run(); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if want := []string{"root", "child", "root"}; !slices.Equal(visited, want) {
+		t.Errorf("visited = %v, want %v", visited, want)
+	}
+	if nav.Depth() != 0 {
+		t.Errorf("Depth() = %d, want 0", nav.Depth())
+	}
+}
+
+func TestNavigatorRunsScreenBelowWhenScreenPopsItself(t *testing.T) {
+	var visited []string
+	var nav *Navigator
+
+	nav = NewNavigator(func() error {
+		visited = append(visited, "root")
+		return ErrCancelled
+	})
+	nav.Push(func() error {
+		visited = append(visited, "child")
+		return nav.Pop()
+	})
+
+	if err := nav.Run(); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if want := []string{"child", "root"}; !slices.Equal(visited, want) {
+		t.Errorf("visited = %v, want %v", visited, want)
+	}
+}
+
+func TestNavigatorReturnsScreenErrors(t *testing.T) {
+	failure := errors.New("boom")
+	nav := NewNavigator(func() error { return ErrCancelled })
+	nav.Push(func() error { return failure })
+
+	if err := nav.Run(); !errors.Is(err, failure) {
+		t.Fatalf("Run = %v, want %v", err, failure)
+	}
+	if nav.Depth() != 2 {
+		t.Errorf("Depth() = %d, want the stack left as it was", nav.Depth())
+	}
+}
+
+func TestNavigatorPopOnEmptyStack(t *testing.T) {
+	nav := NewNavigator(func() error { return nil })
+
+	if err := nav.Pop(); err != nil {
+		t.Fatalf("Pop: %v", err)
+	}
+	if err := nav.Pop(); !errors.Is(err, ErrNavigatorEmpty) {
+		t.Errorf("Pop on an empty stack = %v, want ErrNavigatorEmpty", err)
+	}
+}
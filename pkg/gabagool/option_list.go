@@ -2,6 +2,9 @@ package gabagool
 
 import (
 	"fmt"
+	"math"
+	"reflect"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -9,6 +12,7 @@ import (
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
 	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
 )
 
 type OptionType int
@@ -18,19 +22,36 @@ const (
 	OptionTypeKeyboard
 	OptionTypeClickable
 	OptionTypeColorPicker // New option type for the color picker
+	OptionTypeSlider
+	OptionTypeToggle
+	OptionTypeSpinner
 )
 
+// SliderConfig sets the range of an OptionTypeSlider or OptionTypeSpinner option. Left and right move the value by Step.
+// Spinners use whole numbers, so their bounds and step are truncated to ints.
+type SliderConfig struct {
+	MinValue float64
+	MaxValue float64
+	Step     float64
+}
+
 // Option represents a single option for a menu item.
 // DisplayName is the text that will be displayed to the user.
 // Value is the value that will be returned when the option is submitted.
-// Type controls the option's behavior. There are four types:
+// Type controls the option's behavior. There are seven types:
 //   - Standard: A standard option that will be displayed to the user.
 //   - Keyboard: A keyboard option that will be displayed to the user.
 //   - Clickable: A clickable option that will be displayed to the user.
 //   - ColorPicker: A hexagonal color picker for selecting colors.
+//   - Slider: A numeric value adjusted with left/right within SliderConfig's range.
+//   - Toggle: An on/off switch flipped with A or left/right.
+//   - Spinner: An integer stepped with left/right within SliderConfig's range; holding speeds it up.
 //
 // KeyboardPrompt is the text that will be displayed to the user when the option is a keyboard option.
 // For ColorPicker type, Value should be an sdl.Color.
+// For Slider type, Value should be a float64 and SliderConfig must be set.
+// For Toggle type, Value should be a bool; a single Option is enough.
+// For Spinner type, Value should be an int and SliderConfig must be set.
 type Option struct {
 	DisplayName    string
 	Value          interface{}
@@ -39,6 +60,8 @@ type Option struct {
 	KeyboardLayout KeyboardLayout // Layout to use for keyboard input (default: KeyboardLayoutGeneral)
 	URLShortcuts   []URLShortcut  // Custom shortcuts for URL keyboard (up to 10, only used when KeyboardLayout is KeyboardLayoutURL)
 	Masked         bool
+	SliderConfig   *SliderConfig // Range and step for OptionTypeSlider
+	DefaultValue   interface{}   // Restored by OptionListSettings.ResetButton; nil disables reset for the item
 	OnUpdate       func(newValue interface{})
 }
 
@@ -52,7 +75,22 @@ type OptionListSettings struct {
 	ActionButton          constants.VirtualButton
 	SecondaryActionButton constants.VirtualButton
 	ConfirmButton         constants.VirtualButton // Default: VirtualButtonStart
+	DiscardButton         constants.VirtualButton // Leaves with ListActionDiscarded when there are unsaved changes; may be B
+	ResetButton           constants.VirtualButton // Puts the selected item back to its option's DefaultValue
 	StatusBar             StatusBarOptions
+	KeyboardBackdrop      bool   // Draw the keyboard over a dimmed capture of the list
+	ScrollLongValues      bool   // Marquee-scroll the selected row's option value when it doesn't fit
+	ThemeOverride         *Theme // Renders this list with its own theme instead of the global one
+
+	// Sections replaces the items passed to OptionsList. Each header becomes its own row in the result's Items,
+	// so OptionsListResult.Selected indexes the flattened list, headers included.
+	Sections []OptionSection
+}
+
+// OptionSection groups items under a header row in an OptionsList. See OptionListSettings.Sections.
+type OptionSection struct {
+	Header string
+	Items  []ItemWithOptions
 }
 
 // ItemWithOptions represents a menu item with multiple choices.
@@ -70,14 +108,16 @@ type ItemWithOptions struct {
 	Visible        func() bool  // nil = always visible
 	VisibleWhen    *atomic.Bool // if set, takes precedence over Visible
 	colorPicker    *ColorPicker
+	sectionHeader  bool
 }
 
 func (iow *ItemWithOptions) Value() interface{} {
-	if iow.Options[iow.SelectedOption].Value == nil {
+	// Section header rows carry no options
+	if len(iow.Options) == 0 || iow.Options[iow.SelectedOption].Value == nil {
 		return ""
 	}
 
-	return fmt.Sprintf("%s", iow.Options[iow.SelectedOption].Value)
+	return fmt.Sprintf("%v", iow.Options[iow.SelectedOption].Value)
 }
 
 // IsVisible returns whether the item should be displayed.
@@ -93,6 +133,69 @@ func (iow *ItemWithOptions) IsVisible() bool {
 	return iow.Visible()
 }
 
+// selectable reports whether the item can take focus: it must be visible and not a section header.
+func (iow *ItemWithOptions) selectable() bool {
+	return !iow.sectionHeader && iow.IsVisible()
+}
+
+// optionSnapshot records an item's chosen option and option values when the list opened.
+type optionSnapshot struct {
+	selectedOption int
+	values         []interface{}
+}
+
+func snapshotOptions(items []ItemWithOptions) []optionSnapshot {
+	snapshots := make([]optionSnapshot, len(items))
+	for i, item := range items {
+		snapshots[i].selectedOption = item.SelectedOption
+		for _, option := range item.Options {
+			snapshots[i].values = append(snapshots[i].values, option.Value)
+		}
+	}
+	return snapshots
+}
+
+// isDirty reports whether the item at index differs from when the list opened.
+func (olc *optionsListController) isDirty(index int) bool {
+	if index < 0 || index >= len(olc.initialValues) {
+		return false
+	}
+
+	item := olc.Items[index]
+	snapshot := olc.initialValues[index]
+	if item.SelectedOption != snapshot.selectedOption || len(item.Options) != len(snapshot.values) {
+		return true
+	}
+	for i, option := range item.Options {
+		if !reflect.DeepEqual(option.Value, snapshot.values[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasUnsavedChanges reports whether any option was changed since the list opened.
+func (olc *optionsListController) HasUnsavedChanges() bool {
+	for i := range olc.Items {
+		if olc.isDirty(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenOptionSections turns sections into a single item slice with a header row before each section.
+func flattenOptionSections(sections []OptionSection) []ItemWithOptions {
+	var items []ItemWithOptions
+	for _, section := range sections {
+		if section.Header != "" {
+			items = append(items, ItemWithOptions{Item: MenuItem{Text: section.Header}, sectionHeader: true})
+		}
+		items = append(items, section.Items...)
+	}
+	return items
+}
+
 // OptionsListResult represents the return value of the OptionsList function.
 // Items is the entire list of menu items.
 // Selected is the index of the selected item.
@@ -121,7 +224,12 @@ type internalOptionsListSettings struct {
 	ActionButton          constants.VirtualButton
 	SecondaryActionButton constants.VirtualButton
 	ConfirmButton         constants.VirtualButton
+	DiscardButton         constants.VirtualButton
+	ResetButton           constants.VirtualButton
 	StatusBar             StatusBarOptions
+	KeyboardBackdrop      bool
+	ScrollLongValues      bool
+	ThemeOverride         *Theme
 }
 
 type optionsListController struct {
@@ -140,6 +248,7 @@ type optionsListController struct {
 	ShowingHelp bool
 
 	itemScrollData       map[int]*internal.TextScrollData
+	initialValues        []optionSnapshot
 	showingColorPicker   bool
 	activeColorPickerIdx int
 
@@ -150,18 +259,21 @@ type optionsListController struct {
 	repeatDelay    time.Duration
 	repeatInterval time.Duration
 	hasRepeated    bool
+
+	// How many times left/right has repeated while held, used to speed up spinners
+	horizontalRepeats int
 }
 
 func defaultOptionsListSettings(title string) internalOptionsListSettings {
 	return internalOptionsListSettings{
-		Margins:         internal.UniformPadding(20),
+		Margins:         internal.UniformPadding(20).WithSafeArea(),
 		ItemSpacing:     60,
 		InputDelay:      constants.DefaultInputDelay,
 		Title:           title,
 		TitleAlign:      constants.TextAlignLeft,
 		TitleSpacing:    constants.DefaultTitleSpacing,
-		ScrollSpeed:     150.0,
-		ScrollPauseTime: 25,
+		ScrollSpeed:     4.0,
+		ScrollPauseTime: 1250,
 		FooterTextColor: sdl.Color{R: 180, G: 180, B: 180, A: 255},
 		FooterHelpItems: []FooterHelpItem{},
 		ConfirmButton:   constants.VirtualButtonStart,
@@ -180,9 +292,9 @@ func newOptionsListController(title string, items []ItemWithOptions) *optionsLis
 	}
 
 	// Ensure selected item is visible; if not, find first visible item
-	if len(items) > 0 && !items[selectedIndex].IsVisible() {
+	if len(items) > 0 && !items[selectedIndex].selectable() {
 		for i := range items {
-			if items[i].IsVisible() {
+			if items[i].selectable() {
 				selectedIndex = i
 				break
 			}
@@ -241,9 +353,10 @@ func newOptionsListController(title string, items []ItemWithOptions) *optionsLis
 		Items:                items,
 		SelectedIndex:        selectedIndex,
 		Settings:             defaultOptionsListSettings(title),
-		StartY:               20,
+		StartY:               20 + internal.GetSafeArea().Top,
 		lastInputTime:        time.Now(),
 		itemScrollData:       make(map[int]*internal.TextScrollData),
+		initialValues:        snapshotOptions(items),
 		showingColorPicker:   false,
 		activeColorPickerIdx: -1,
 		lastRepeatTime:       time.Now(),
@@ -255,10 +368,16 @@ func newOptionsListController(title string, items []ItemWithOptions) *optionsLis
 // OptionsList presents a list of options to the user.
 // This blocks until a selection is made or the user cancels.
 func OptionsList(title string, listOptions OptionListSettings, items []ItemWithOptions) (*OptionsListResult, error) {
+	defer internal.GetInputProcessor().StartCooldown()
+
 	window := internal.GetWindow()
 	renderer := window.Renderer
 	processor := internal.GetInputProcessor()
 
+	if len(listOptions.Sections) > 0 {
+		items = flattenOptionSections(listOptions.Sections)
+	}
+
 	optionsListController := newOptionsListController(title, items)
 
 	optionsListController.MaxVisibleItems = int(optionsListController.calculateMaxVisibleItems(window))
@@ -268,14 +387,20 @@ func OptionsList(title string, listOptions OptionListSettings, items []ItemWithO
 	optionsListController.Settings.HelpExitText = listOptions.HelpExitText
 	optionsListController.Settings.ActionButton = listOptions.ActionButton
 	optionsListController.Settings.SecondaryActionButton = listOptions.SecondaryActionButton
+	optionsListController.Settings.DiscardButton = listOptions.DiscardButton
+	optionsListController.Settings.ResetButton = listOptions.ResetButton
 	optionsListController.Settings.StatusBar = listOptions.StatusBar
+	optionsListController.Settings.KeyboardBackdrop = listOptions.KeyboardBackdrop
+	optionsListController.Settings.ScrollLongValues = listOptions.ScrollLongValues
+	optionsListController.Settings.ThemeOverride = listOptions.ThemeOverride
 
 	// Use provided ConfirmButton or default to VirtualButtonStart
 	if listOptions.ConfirmButton != constants.VirtualButtonUnassigned {
 		optionsListController.Settings.ConfirmButton = listOptions.ConfirmButton
 	}
 
-	if listOptions.InitialSelectedIndex > 0 && listOptions.InitialSelectedIndex < len(items) {
+	if listOptions.InitialSelectedIndex > 0 && listOptions.InitialSelectedIndex < len(items) &&
+		!items[listOptions.InitialSelectedIndex].sectionHeader {
 		if optionsListController.SelectedIndex >= 0 && optionsListController.SelectedIndex < len(items) {
 			optionsListController.Items[optionsListController.SelectedIndex].Item.Selected = false
 		}
@@ -298,13 +423,13 @@ func OptionsList(title string, listOptions OptionListSettings, items []ItemWithO
 	var err error
 
 	for running {
-		if event := sdl.WaitEventTimeout(16); event != nil {
+		if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
 			switch event.(type) {
 			case *sdl.QuitEvent:
 				running = false
 				err = sdl.GetError()
 
-			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent:
+			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
 				inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
 				if inputEvent == nil {
 					continue
@@ -323,8 +448,9 @@ func OptionsList(title string, listOptions OptionListSettings, items []ItemWithO
 		}
 
 		optionsListController.handleDirectionalRepeats()
+		optionsListController.updateScrolling()
 
-		if window.Background != nil {
+		if window.HasBackground() {
 			window.RenderBackground()
 		} else {
 			renderer.SetDrawColor(0, 0, 0, 255)
@@ -343,7 +469,9 @@ func OptionsList(title string, listOptions OptionListSettings, items []ItemWithO
 			optionsListController.render(renderer)
 		}
 
-		renderer.Present()
+		logInspection("option_list", InspectOptionList(optionsListController))
+		internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: optionsListController.SelectedIndex, TextLength: -1})
+		window.Present()
 	}
 
 	if err != nil {
@@ -361,21 +489,21 @@ func OptionsList(title string, listOptions OptionListSettings, items []ItemWithO
 func (olc *optionsListController) calculateMaxVisibleItems(window *internal.Window) int32 {
 	scaleFactor := internal.GetScaleFactor()
 
-	itemSpacing := int32(float32(60) * scaleFactor)
+	itemSpacing := int32(float32(60) * scaleFactor * internal.GetFontScale())
 
 	_, screenHeight, _ := window.Renderer.GetOutputSize()
 
 	var titleHeight int32 = 0
 	if olc.Settings.Title != "" {
 		if olc.Settings.SmallTitle {
-			titleHeight = int32(float32(50) * scaleFactor)
+			titleHeight = int32(float32(50) * scaleFactor * internal.GetFontScale())
 		} else {
-			titleHeight = int32(float32(60) * scaleFactor)
+			titleHeight = int32(float32(60) * scaleFactor * internal.GetFontScale())
 		}
 		titleHeight += olc.Settings.TitleSpacing
 	}
 
-	footerHeight := int32(float32(50) * scaleFactor)
+	footerHeight := int32(float32(50) * scaleFactor * internal.GetFontScale())
 
 	availableHeight := screenHeight - titleHeight - footerHeight - olc.StartY
 
@@ -461,6 +589,9 @@ func (olc *optionsListController) handleOptionsInput(inputEvent *internal.Event,
 	case constants.VirtualButtonB:
 		if olc.ShowingHelp {
 			olc.ShowingHelp = false
+		} else if olc.Settings.DiscardButton == constants.VirtualButtonB && olc.HasUnsavedChanges() {
+			*running = false
+			result.Action = ListActionDiscarded
 		} else if !olc.Settings.DisableBackButton {
 			*running = false
 			*cancelled = true
@@ -477,6 +608,7 @@ func (olc *optionsListController) handleOptionsInput(inputEvent *internal.Event,
 
 	case constants.VirtualButtonLeft:
 		if !olc.ShowingHelp {
+			olc.horizontalRepeats = 0
 			olc.cycleOptionLeft()
 			olc.heldDirections.left = true
 			olc.heldDirections.right = false
@@ -486,6 +618,7 @@ func (olc *optionsListController) handleOptionsInput(inputEvent *internal.Event,
 
 	case constants.VirtualButtonRight:
 		if !olc.ShowingHelp {
+			olc.horizontalRepeats = 0
 			olc.cycleOptionRight()
 			olc.heldDirections.right = true
 			olc.heldDirections.left = false
@@ -516,6 +649,27 @@ func (olc *optionsListController) handleOptionsInput(inputEvent *internal.Event,
 		olc.lastInputTime = time.Now()
 
 	default:
+		if footerButtonDisabled(olc.Settings.FooterHelpItems, inputEvent.Button) {
+			return
+		}
+
+		if olc.Settings.ResetButton != constants.VirtualButtonUnassigned &&
+			inputEvent.Button == olc.Settings.ResetButton {
+			if !olc.ShowingHelp {
+				olc.resetToDefault()
+			}
+			olc.lastInputTime = time.Now()
+		}
+
+		if olc.Settings.DiscardButton != constants.VirtualButtonUnassigned &&
+			inputEvent.Button == olc.Settings.DiscardButton {
+			if !olc.ShowingHelp && olc.HasUnsavedChanges() {
+				*running = false
+				result.Action = ListActionDiscarded
+			}
+			olc.lastInputTime = time.Now()
+		}
+
 		// Handle configurable action buttons
 		if olc.Settings.ConfirmButton != constants.VirtualButtonUnassigned &&
 			inputEvent.Button == olc.Settings.ConfirmButton {
@@ -599,10 +753,12 @@ func (olc *optionsListController) handleDirectionalRepeats() {
 			}
 		} else if olc.heldDirections.left {
 			if !olc.ShowingHelp {
+				olc.horizontalRepeats++
 				olc.cycleOptionLeft()
 			}
 		} else if olc.heldDirections.right {
 			if !olc.ShowingHelp {
+				olc.horizontalRepeats++
 				olc.cycleOptionRight()
 			}
 		}
@@ -617,18 +773,23 @@ func (olc *optionsListController) handleAButton(running *bool, result *OptionsLi
 			switch o.Type {
 			case OptionTypeKeyboard:
 				prompt := o.KeyboardPrompt
-				var keyboardResult *KeyboardResult
-				var err error
-
-				// Use URLKeyboard if layout is URL and shortcuts are provided
-				if o.KeyboardLayout == KeyboardLayoutURL && len(o.URLShortcuts) > 0 {
-					keyboardResult, err = URLKeyboard(prompt, olc.Settings.HelpExitText, URLKeyboardConfig{
-						Shortcuts: o.URLShortcuts,
-					})
-				} else {
-					keyboardResult, err = Keyboard(prompt, olc.Settings.HelpExitText, o.KeyboardLayout)
+
+				var backdrop *sdl.Texture
+				if olc.Settings.KeyboardBackdrop {
+					backdrop = olc.captureFrame(internal.GetWindow())
+					if backdrop != nil {
+						defer backdrop.Destroy()
+					}
 				}
 
+				keyboardOptions := DefaultKeyboardOptions()
+				keyboardOptions.Layout = o.KeyboardLayout
+				keyboardOptions.URLShortcuts = o.URLShortcuts
+				keyboardOptions.HelpExitText = olc.Settings.HelpExitText
+				keyboardOptions.Backdrop = backdrop
+
+				keyboardResult, err := KeyboardWithOptions(prompt, keyboardOptions)
+
 				if err == nil {
 					enteredText := keyboardResult.Text
 					item.Options[item.SelectedOption] = Option{
@@ -643,6 +804,8 @@ func (olc *optionsListController) handleAButton(running *bool, result *OptionsLi
 				}
 			case OptionTypeColorPicker:
 				olc.showColorPicker(olc.SelectedIndex)
+			case OptionTypeToggle:
+				olc.flipToggle(&item.Options[item.SelectedOption])
 			case OptionTypeClickable:
 				*running = false
 				result.Action = ListActionSelected
@@ -652,6 +815,33 @@ func (olc *optionsListController) handleAButton(running *bool, result *OptionsLi
 	}
 }
 
+// captureFrame renders the current list into an offscreen texture so it can be used as a backdrop.
+func (olc *optionsListController) captureFrame(window *internal.Window) *sdl.Texture {
+	renderer := window.Renderer
+
+	texture, err := renderer.CreateTexture(sdl.PIXELFORMAT_RGBA8888, sdl.TEXTUREACCESS_TARGET, window.GetWidth(), window.GetHeight())
+	if err != nil {
+		return nil
+	}
+
+	if err := renderer.SetRenderTarget(texture); err != nil {
+		texture.Destroy()
+		return nil
+	}
+
+	if window.HasBackground() {
+		window.RenderBackground()
+	} else {
+		renderer.SetDrawColor(0, 0, 0, 255)
+		renderer.Clear()
+	}
+	olc.render(renderer)
+
+	renderer.SetRenderTarget(nil)
+
+	return texture
+}
+
 func (olc *optionsListController) moveSelection(direction int) {
 	if len(olc.Items) == 0 {
 		return
@@ -681,7 +871,7 @@ func (olc *optionsListController) moveSelection(direction int) {
 		}
 
 		// If item is visible, we found our target
-		if olc.Items[olc.SelectedIndex].IsVisible() {
+		if olc.Items[olc.SelectedIndex].selectable() {
 			break
 		}
 
@@ -737,6 +927,21 @@ func (olc *optionsListController) cycleOptionLeft() {
 		return
 	}
 
+	if item.Options[item.SelectedOption].Type == OptionTypeSlider {
+		olc.stepSlider(&item.Options[item.SelectedOption], -1)
+		return
+	}
+
+	if item.Options[item.SelectedOption].Type == OptionTypeToggle {
+		olc.flipToggle(&item.Options[item.SelectedOption])
+		return
+	}
+
+	if item.Options[item.SelectedOption].Type == OptionTypeSpinner {
+		olc.stepSpinner(&item.Options[item.SelectedOption], -1)
+		return
+	}
+
 	item.SelectedOption--
 	if item.SelectedOption < 0 {
 		item.SelectedOption = len(item.Options) - 1
@@ -762,6 +967,21 @@ func (olc *optionsListController) cycleOptionRight() {
 		return
 	}
 
+	if item.Options[item.SelectedOption].Type == OptionTypeSlider {
+		olc.stepSlider(&item.Options[item.SelectedOption], +1)
+		return
+	}
+
+	if item.Options[item.SelectedOption].Type == OptionTypeToggle {
+		olc.flipToggle(&item.Options[item.SelectedOption])
+		return
+	}
+
+	if item.Options[item.SelectedOption].Type == OptionTypeSpinner {
+		olc.stepSpinner(&item.Options[item.SelectedOption], +1)
+		return
+	}
+
 	item.SelectedOption++
 	if item.SelectedOption >= len(item.Options) {
 		item.SelectedOption = 0
@@ -773,6 +993,161 @@ func (olc *optionsListController) cycleOptionRight() {
 	}
 }
 
+// stepSlider moves a slider option's value by one step in direction, clamped to its range.
+func (olc *optionsListController) stepSlider(option *Option, direction int) {
+	config := option.SliderConfig
+	if config == nil || config.Step <= 0 {
+		return
+	}
+
+	current := sliderValue(*option)
+	value := current + float64(direction)*config.Step
+	value = math.Max(config.MinValue, math.Min(config.MaxValue, value))
+	if value == current {
+		return
+	}
+
+	option.Value = value
+	if option.OnUpdate != nil {
+		option.OnUpdate(value)
+	}
+}
+
+// resetToDefault puts the selected item back to its option's DefaultValue.
+// Standard items select the option whose Value matches the default; other types take the default as their value.
+func (olc *optionsListController) resetToDefault() {
+	if olc.SelectedIndex < 0 || olc.SelectedIndex >= len(olc.Items) {
+		return
+	}
+
+	item := &olc.Items[olc.SelectedIndex]
+	if len(item.Options) == 0 {
+		return
+	}
+
+	option := &item.Options[item.SelectedOption]
+	def := option.DefaultValue
+	if def == nil || olc.isDefault(*item) {
+		return
+	}
+
+	switch option.Type {
+	case OptionTypeStandard:
+		for i := range item.Options {
+			if reflect.DeepEqual(item.Options[i].Value, def) {
+				item.SelectedOption = i
+				option = &item.Options[i]
+				break
+			}
+		}
+		if !reflect.DeepEqual(option.Value, def) {
+			return
+		}
+	case OptionTypeKeyboard:
+		option.Value = def
+		option.DisplayName = fmt.Sprintf("%v", def)
+		option.KeyboardPrompt = option.DisplayName
+	case OptionTypeColorPicker:
+		option.Value = def
+		if color, ok := def.(sdl.Color); ok {
+			option.DisplayName = fmt.Sprintf("#%02X%02X%02X", color.R, color.G, color.B)
+		}
+	default:
+		option.Value = def
+	}
+
+	if option.OnUpdate != nil {
+		option.OnUpdate(def)
+	}
+}
+
+// isDefault reports whether an item's selected option holds its DefaultValue.
+func (olc *optionsListController) isDefault(item ItemWithOptions) bool {
+	if len(item.Options) == 0 {
+		return false
+	}
+	option := item.Options[item.SelectedOption]
+	return option.DefaultValue != nil && reflect.DeepEqual(option.Value, option.DefaultValue)
+}
+
+// stepSpinner moves a spinner option's value by its step in direction, clamped to its range.
+// While left/right is held the step grows, so long ranges can be crossed quickly.
+func (olc *optionsListController) stepSpinner(option *Option, direction int) {
+	config := option.SliderConfig
+	if config == nil {
+		return
+	}
+
+	step := max(int(config.Step), 1)
+	step *= min(1+olc.horizontalRepeats/10, 10)
+
+	current := spinnerValue(*option)
+	value := max(int(config.MinValue), min(int(config.MaxValue), current+direction*step))
+	if value == current {
+		return
+	}
+
+	option.Value = value
+	if option.OnUpdate != nil {
+		option.OnUpdate(value)
+	}
+}
+
+// spinnerValue returns a spinner option's value as an int, accepting the common numeric types.
+func spinnerValue(option Option) int {
+	switch v := option.Value.(type) {
+	case int:
+		return v
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	if option.SliderConfig != nil {
+		return int(option.SliderConfig.MinValue)
+	}
+	return 0
+}
+
+// flipToggle switches a toggle option between true and false.
+func (olc *optionsListController) flipToggle(option *Option) {
+	on, _ := option.Value.(bool)
+	option.Value = !on
+	if option.OnUpdate != nil {
+		option.OnUpdate(option.Value)
+	}
+}
+
+// formatSliderValue shows value with as many decimals as the slider's step, hiding float rounding noise.
+func formatSliderValue(option Option, value float64) string {
+	decimals := 0
+	if option.SliderConfig != nil {
+		step := strconv.FormatFloat(option.SliderConfig.Step, 'f', -1, 64)
+		if dot := strings.IndexByte(step, '.'); dot >= 0 {
+			decimals = len(step) - dot - 1
+		}
+	}
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
+
+// sliderValue returns a slider option's value as a float64, accepting the common numeric types.
+func sliderValue(option Option) float64 {
+	switch v := option.Value.(type) {
+	case float64:
+		return v
+	case float32:
+		return float64(v)
+	case int:
+		return float64(v)
+	}
+	if option.SliderConfig != nil {
+		return option.SliderConfig.MinValue
+	}
+	return 0
+}
+
 func (olc *optionsListController) scrollTo(index int) {
 	if index < 0 || index >= len(olc.Items) {
 		return
@@ -813,6 +1188,9 @@ func (olc *optionsListController) toggleHelp() {
 			"• A: Select or input text for keyboard options",
 			"• B: Cancel and exit",
 		}
+		if olc.Settings.ResetButton != constants.VirtualButtonUnassigned {
+			helpLines = append(helpLines, fmt.Sprintf("• %s: Reset item to its default", olc.Settings.ResetButton.GetName()))
+		}
 		olc.helpOverlay = newHelpOverlay(fmt.Sprintf("%s Help", olc.Settings.Title), helpLines, olc.Settings.HelpExitText)
 	}
 }
@@ -825,6 +1203,8 @@ func (olc *optionsListController) scrollHelpOverlay(direction int) {
 }
 
 func (olc *optionsListController) render(renderer *sdl.Renderer) {
+	defer internal.OverrideTheme(olc.Settings.ThemeOverride)()
+
 	if olc.ShowingHelp && olc.helpOverlay != nil {
 		olc.helpOverlay.render(renderer, internal.Fonts.SmallFont)
 		return
@@ -838,8 +1218,8 @@ func (olc *optionsListController) render(renderer *sdl.Renderer) {
 	}
 	font := internal.Fonts.SmallFont
 
-	itemSpacing := int32(float32(60) * scaleFactor)
-	selectionRectHeight := int32(float32(60) * scaleFactor)
+	itemSpacing := int32(float32(60) * scaleFactor * internal.GetFontScale())
+	selectionRectHeight := int32(float32(60) * scaleFactor * internal.GetFontScale())
 	cornerRadius := int32(float32(20) * scaleFactor)
 
 	statusBarWidth := calculateStatusBarWidth(internal.Fonts.SmallFont, olc.Settings.StatusBar)
@@ -891,6 +1271,12 @@ func (olc *optionsListController) render(renderer *sdl.Renderer) {
 			continue
 		}
 
+		if item.sectionHeader {
+			olc.renderSectionHeader(renderer, item.Item.Text, olc.StartY+int32(displayPosition)*itemSpacing-5, selectionRectHeight)
+			displayPosition++
+			continue
+		}
+
 		textColor := internal.GetTheme().TextColor
 		bgColor := sdl.Color{R: 0, G: 0, B: 0, A: 0}
 
@@ -914,8 +1300,10 @@ func (olc *optionsListController) render(renderer *sdl.Renderer) {
 		// Calculate vertical center within selection rect
 		selectionRectY := itemY - 5
 
+		var itemTextWidth int32
 		itemSurface, _ := font.RenderUTF8Blended(item.Item.Text, textColor)
 		if itemSurface != nil {
+			itemTextWidth = itemSurface.W
 			defer itemSurface.Free()
 			itemTexture, _ := renderer.CreateTextureFromSurface(itemSurface)
 			if itemTexture != nil {
@@ -929,6 +1317,14 @@ func (olc *optionsListController) render(renderer *sdl.Renderer) {
 			}
 		}
 
+		if olc.isDirty(itemIndex) {
+			itemTextWidth += olc.renderUnsavedDot(renderer, olc.Settings.Margins.Left+itemTextWidth, selectionRectY, selectionRectHeight)
+		}
+
+		if olc.Settings.ResetButton != constants.VirtualButtonUnassigned && olc.isDefault(item) {
+			itemTextWidth += olc.renderDefaultTag(renderer, olc.Settings.Margins.Left+itemTextWidth, selectionRectY, selectionRectHeight)
+		}
+
 		if len(item.Options) > 0 {
 			selectedOption := item.Options[item.SelectedOption]
 
@@ -941,39 +1337,11 @@ func (olc *optionsListController) render(renderer *sdl.Renderer) {
 					indicatorText = selectedOption.DisplayName
 				}
 
-				optionSurface, _ := font.RenderUTF8Blended(indicatorText, textColor)
-				if optionSurface != nil {
-					defer optionSurface.Free()
-					optionTexture, _ := renderer.CreateTextureFromSurface(optionSurface)
-					if optionTexture != nil {
-						defer optionTexture.Destroy()
-
-						renderer.Copy(optionTexture, nil, &sdl.Rect{
-							X: window.GetWidth() - olc.Settings.Margins.Right - optionSurface.W,
-							Y: selectionRectY + (selectionRectHeight-optionSurface.H)/2,
-							W: optionSurface.W,
-							H: optionSurface.H,
-						})
-					}
-				}
+				olc.renderOptionValue(renderer, font, indicatorText, textColor, itemIndex, item.Item.Selected, itemTextWidth, selectionRectY, selectionRectHeight)
 			} else if selectedOption.Type == OptionTypeClickable {
 				indicatorText := selectedOption.DisplayName
 
-				optionSurface, _ := font.RenderUTF8Blended(indicatorText, textColor)
-				if optionSurface != nil {
-					defer optionSurface.Free()
-					optionTexture, _ := renderer.CreateTextureFromSurface(optionSurface)
-					if optionTexture != nil {
-						defer optionTexture.Destroy()
-
-						renderer.Copy(optionTexture, nil, &sdl.Rect{
-							X: window.GetWidth() - olc.Settings.Margins.Right - optionSurface.W,
-							Y: selectionRectY + (selectionRectHeight-optionSurface.H)/2,
-							W: optionSurface.W,
-							H: optionSurface.H,
-						})
-					}
-				}
+				olc.renderOptionValue(renderer, font, indicatorText, textColor, itemIndex, item.Item.Selected, itemTextWidth, selectionRectY, selectionRectHeight)
 			} else if selectedOption.Type == OptionTypeColorPicker {
 				// For color picker option, display the color swatch and hex value
 				indicatorText := selectedOption.DisplayName
@@ -1042,22 +1410,15 @@ func (olc *optionsListController) render(renderer *sdl.Renderer) {
 						}
 					}
 				}
+			} else if selectedOption.Type == OptionTypeToggle {
+				on, _ := selectedOption.Value.(bool)
+				olc.renderToggle(renderer, on, selectionRectY, selectionRectHeight)
+			} else if selectedOption.Type == OptionTypeSpinner {
+				olc.renderSpinner(renderer, font, spinnerValue(selectedOption), textColor, selectionRectY, selectionRectHeight)
+			} else if selectedOption.Type == OptionTypeSlider {
+				olc.renderSlider(renderer, font, selectedOption, textColor, selectionRectY, selectionRectHeight)
 			} else {
-				optionSurface, _ := font.RenderUTF8Blended(selectedOption.DisplayName, textColor)
-				if optionSurface != nil {
-					defer optionSurface.Free()
-					optionTexture, _ := renderer.CreateTextureFromSurface(optionSurface)
-					if optionTexture != nil {
-						defer optionTexture.Destroy()
-
-						renderer.Copy(optionTexture, nil, &sdl.Rect{
-							X: window.GetWidth() - olc.Settings.Margins.Right - optionSurface.W,
-							Y: selectionRectY + (selectionRectHeight-optionSurface.H)/2,
-							W: optionSurface.W,
-							H: optionSurface.H,
-						})
-					}
-				}
+				olc.renderOptionValue(renderer, font, selectedOption.DisplayName, textColor, itemIndex, item.Item.Selected, itemTextWidth, selectionRectY, selectionRectHeight)
 			}
 		}
 
@@ -1068,8 +1429,285 @@ func (olc *optionsListController) render(renderer *sdl.Renderer) {
 		renderer,
 		internal.Fonts.SmallFont,
 		olc.Settings.FooterHelpItems,
-		olc.Settings.Margins.Bottom,
+		olc.Settings.Margins,
 		true,
 		true,
 	)
 }
+
+// renderUnsavedDot marks a changed item with a dot after its label at x, and returns the width it took.
+func (olc *optionsListController) renderUnsavedDot(renderer *sdl.Renderer, x, rowY, rowHeight int32) int32 {
+	gap := int32(float32(10) * internal.GetScaleFactor())
+	size := int32(float32(10) * internal.GetScaleFactor())
+
+	dotRect := &sdl.Rect{X: x + gap, Y: rowY + (rowHeight-size)/2, W: size, H: size}
+	internal.DrawRoundedRect(renderer, dotRect, size/2, internal.GetTheme().AccentColor)
+
+	return gap + size
+}
+
+// renderDefaultTag labels an item still at its default value with a small "default" tag at x, and returns the width it took.
+func (olc *optionsListController) renderDefaultTag(renderer *sdl.Renderer, x, rowY, rowHeight int32) int32 {
+	surface, _ := internal.Fonts.TinyFont.RenderUTF8Blended("default", internal.GetTheme().HintColor)
+	if surface == nil {
+		return 0
+	}
+	defer surface.Free()
+
+	texture, _ := renderer.CreateTextureFromSurface(surface)
+	if texture == nil {
+		return 0
+	}
+	defer texture.Destroy()
+
+	gap := int32(float32(12) * internal.GetScaleFactor())
+	renderer.Copy(texture, nil, &sdl.Rect{
+		X: x + gap,
+		Y: rowY + (rowHeight-surface.H)/2,
+		W: surface.W,
+		H: surface.H,
+	})
+
+	return gap + surface.W
+}
+
+// renderSectionHeader draws a section's header row, dimmed so it reads as a label rather than a setting.
+func (olc *optionsListController) renderSectionHeader(renderer *sdl.Renderer, header string, rowY, rowHeight int32) {
+	surface, _ := internal.Fonts.SmallFont.RenderUTF8Blended(header, internal.GetTheme().HintColor)
+	if surface == nil {
+		return
+	}
+	defer surface.Free()
+
+	texture, _ := renderer.CreateTextureFromSurface(surface)
+	if texture == nil {
+		return
+	}
+	defer texture.Destroy()
+
+	renderer.Copy(texture, nil, &sdl.Rect{
+		X: olc.Settings.Margins.Left,
+		Y: rowY + (rowHeight-surface.H)/2,
+		W: surface.W,
+		H: surface.H,
+	})
+}
+
+// renderToggle draws an on/off switch at the right of a row: the knob sits right on an accent track when on,
+// and left on a gray track when off.
+func (olc *optionsListController) renderToggle(renderer *sdl.Renderer, on bool, rowY, rowHeight int32) {
+	scaleFactor := internal.GetScaleFactor()
+	window := internal.GetWindow()
+	theme := internal.GetTheme()
+
+	trackWidth := int32(float32(64) * scaleFactor)
+	trackHeight := int32(float32(32) * scaleFactor)
+	trackRect := &sdl.Rect{
+		X: window.GetWidth() - olc.Settings.Margins.Right - trackWidth,
+		Y: rowY + (rowHeight-trackHeight)/2,
+		W: trackWidth,
+		H: trackHeight,
+	}
+
+	trackColor := sdl.Color{R: 80, G: 80, B: 80, A: 255}
+	if on {
+		trackColor = theme.AccentColor
+	}
+	internal.DrawRoundedRect(renderer, trackRect, trackHeight/2, trackColor)
+
+	inset := int32(float32(4) * scaleFactor)
+	knobSize := trackHeight - inset*2
+	knobX := trackRect.X + inset
+	if on {
+		knobX = trackRect.X + trackWidth - inset - knobSize
+	}
+	knobRect := &sdl.Rect{X: knobX, Y: trackRect.Y + inset, W: knobSize, H: knobSize}
+	internal.DrawRoundedRect(renderer, knobRect, knobSize/2, theme.TextColor)
+}
+
+// renderSpinner draws a spinner's value in a pill at the right of its row, between < and > arrows.
+func (olc *optionsListController) renderSpinner(renderer *sdl.Renderer, font *ttf.Font, value int, color sdl.Color, rowY, rowHeight int32) {
+	scaleFactor := internal.GetScaleFactor()
+	window := internal.GetWindow()
+
+	padding := int32(float32(14) * scaleFactor)
+	pillHeight := int32(float32(40) * scaleFactor * internal.GetFontScale())
+	valueWidth := internal.Max32(olc.measureText(font, strconv.Itoa(value)), int32(float32(60)*scaleFactor))
+	arrowWidth := olc.measureText(font, ">")
+	pillWidth := valueWidth + arrowWidth*2 + padding*4
+
+	pillRect := &sdl.Rect{
+		X: window.GetWidth() - olc.Settings.Margins.Right - pillWidth,
+		Y: rowY + (rowHeight-pillHeight)/2,
+		W: pillWidth,
+		H: pillHeight,
+	}
+	internal.DrawRoundedRect(renderer, pillRect, pillHeight/2, sdl.Color{R: 60, G: 60, B: 60, A: 255})
+
+	olc.renderCenteredText(renderer, font, "<", color, pillRect.X+padding, arrowWidth, rowY, rowHeight)
+	olc.renderCenteredText(renderer, font, strconv.Itoa(value), color, pillRect.X+padding*2+arrowWidth, valueWidth, rowY, rowHeight)
+	olc.renderCenteredText(renderer, font, ">", color, pillRect.X+pillRect.W-padding-arrowWidth, arrowWidth, rowY, rowHeight)
+}
+
+func (olc *optionsListController) measureText(font *ttf.Font, text string) int32 {
+	w, _, err := font.SizeUTF8(text)
+	if err != nil {
+		return 0
+	}
+	return int32(w)
+}
+
+// renderCenteredText draws text centered in the box starting at x with the given width, within a row.
+func (olc *optionsListController) renderCenteredText(renderer *sdl.Renderer, font *ttf.Font, text string, color sdl.Color, x, width, rowY, rowHeight int32) {
+	surface, _ := font.RenderUTF8Blended(text, color)
+	if surface == nil {
+		return
+	}
+	defer surface.Free()
+
+	texture, _ := renderer.CreateTextureFromSurface(surface)
+	if texture == nil {
+		return
+	}
+	defer texture.Destroy()
+
+	renderer.Copy(texture, nil, &sdl.Rect{
+		X: x + (width-surface.W)/2,
+		Y: rowY + (rowHeight-surface.H)/2,
+		W: surface.W,
+		H: surface.H,
+	})
+}
+
+// renderSlider draws a slider option as a progress bar at the right of its row, with the current value to its left.
+func (olc *optionsListController) renderSlider(renderer *sdl.Renderer, font *ttf.Font, option Option, color sdl.Color, rowY, rowHeight int32) {
+	scaleFactor := internal.GetScaleFactor()
+	window := internal.GetWindow()
+
+	barWidth := int32(float32(200) * scaleFactor)
+	barHeight := int32(float32(12) * scaleFactor)
+	barX := window.GetWidth() - olc.Settings.Margins.Right - barWidth
+	barRect := &sdl.Rect{X: barX, Y: rowY + (rowHeight-barHeight)/2, W: barWidth, H: barHeight}
+
+	value := sliderValue(option)
+	fillWidth := int32(0)
+	if config := option.SliderConfig; config != nil && config.MaxValue > config.MinValue {
+		fillWidth = int32(float64(barWidth) * (value - config.MinValue) / (config.MaxValue - config.MinValue))
+	}
+
+	internal.DrawSmoothProgressBar(renderer, barRect, fillWidth, sdl.Color{R: 60, G: 60, B: 60, A: 255}, color)
+
+	surface, _ := font.RenderUTF8Blended(formatSliderValue(option, value), color)
+	if surface == nil {
+		return
+	}
+	defer surface.Free()
+
+	texture, _ := renderer.CreateTextureFromSurface(surface)
+	if texture == nil {
+		return
+	}
+	defer texture.Destroy()
+
+	gap := int32(float32(15) * scaleFactor)
+	renderer.Copy(texture, nil, &sdl.Rect{
+		X: barX - gap - surface.W,
+		Y: rowY + (rowHeight-surface.H)/2,
+		W: surface.W,
+		H: surface.H,
+	})
+}
+
+// renderOptionValue draws an option value right-aligned in its row.
+// With ScrollLongValues enabled, values that would overlap the item label are clipped,
+// and the selected row's value scrolls back and forth so it can be read in full.
+func (olc *optionsListController) renderOptionValue(renderer *sdl.Renderer, font *ttf.Font, text string, color sdl.Color, itemIndex int, selected bool, labelWidth, rowY, rowHeight int32) {
+	surface, _ := font.RenderUTF8Blended(text, color)
+	if surface == nil {
+		return
+	}
+	defer surface.Free()
+
+	texture, _ := renderer.CreateTextureFromSurface(surface)
+	if texture == nil {
+		return
+	}
+	defer texture.Destroy()
+
+	window := internal.GetWindow()
+	rightX := window.GetWidth() - olc.Settings.Margins.Right
+	textY := rowY + (rowHeight-surface.H)/2
+
+	labelGap := int32(float32(20) * internal.GetScaleFactor())
+	maxWidth := rightX - olc.Settings.Margins.Left - labelWidth - labelGap
+
+	if !olc.Settings.ScrollLongValues || surface.W <= maxWidth || maxWidth <= 0 {
+		delete(olc.itemScrollData, itemIndex)
+		renderer.Copy(texture, nil, &sdl.Rect{X: rightX - surface.W, Y: textY, W: surface.W, H: surface.H})
+		return
+	}
+
+	clipRect := &sdl.Rect{X: 0, Y: 0, W: maxWidth, H: surface.H}
+
+	if selected {
+		scrollData, exists := olc.itemScrollData[itemIndex]
+		if !exists || scrollData.TextWidth != surface.W || scrollData.ContainerWidth != maxWidth {
+			now := time.Now()
+			scrollData = &internal.TextScrollData{
+				NeedsScrolling:      true,
+				TextWidth:           surface.W,
+				ContainerWidth:      maxWidth,
+				Direction:           1,
+				LastDirectionChange: &now,
+			}
+			olc.itemScrollData[itemIndex] = scrollData
+		}
+		clipRect.X = scrollData.ScrollOffset
+	} else {
+		delete(olc.itemScrollData, itemIndex)
+	}
+
+	renderer.Copy(texture, clipRect, &sdl.Rect{X: rightX - maxWidth, Y: textY, W: maxWidth, H: surface.H})
+}
+
+func (olc *optionsListController) updateScrolling() {
+	if internal.ReducedMotion() {
+		return
+	}
+
+	currentTime := time.Now()
+
+	for idx, data := range olc.itemScrollData {
+		if idx != olc.SelectedIndex {
+			delete(olc.itemScrollData, idx)
+			continue
+		}
+
+		if !data.NeedsScrolling {
+			continue
+		}
+
+		if data.LastDirectionChange != nil && currentTime.Sub(*data.LastDirectionChange) < time.Duration(olc.Settings.ScrollPauseTime)*time.Millisecond {
+			continue
+		}
+
+		data.ScrollOffset += int32(data.Direction) * int32(olc.Settings.ScrollSpeed)
+
+		maxOffset := data.TextWidth - data.ContainerWidth
+		if data.ScrollOffset <= 0 {
+			data.ScrollOffset = 0
+			if data.Direction < 0 {
+				data.Direction = 1
+				now := currentTime
+				data.LastDirectionChange = &now
+			}
+		} else if data.ScrollOffset >= maxOffset {
+			data.ScrollOffset = maxOffset
+			if data.Direction > 0 {
+				data.Direction = -1
+				now := currentTime
+				data.LastDirectionChange = &now
+			}
+		}
+	}
+}
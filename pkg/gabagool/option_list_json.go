@@ -0,0 +1,152 @@
+package gabagool
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// exportedOption is how one item's current value is stored by ExportOptions.
+type exportedOption struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+var optionTypeNames = map[OptionType]string{
+	OptionTypeStandard:    "standard",
+	OptionTypeKeyboard:    "keyboard",
+	OptionTypeClickable:   "clickable",
+	OptionTypeColorPicker: "color",
+	OptionTypeSlider:      "slider",
+	OptionTypeToggle:      "toggle",
+	OptionTypeSpinner:     "spinner",
+}
+
+// ExportOptions serializes the current value of each item to JSON, keyed by Item.Text, so settings can be saved to disk.
+// Standard items store the chosen option's value, keyboard items their text and color pickers a "#RRGGBBAA" string.
+// Section headers and items without options are skipped.
+func ExportOptions(items []ItemWithOptions) ([]byte, error) {
+	exported := make(map[string]exportedOption)
+
+	for _, item := range items {
+		if item.sectionHeader || len(item.Options) == 0 || item.SelectedOption >= len(item.Options) {
+			continue
+		}
+
+		option := item.Options[item.SelectedOption]
+
+		var value interface{}
+		switch option.Type {
+		case OptionTypeColorPicker:
+			color, _ := option.Value.(sdl.Color)
+			value = fmt.Sprintf("#%02X%02X%02X%02X", color.R, color.G, color.B, color.A)
+		case OptionTypeSlider:
+			value = sliderValue(option)
+		case OptionTypeSpinner:
+			value = spinnerValue(option)
+		case OptionTypeToggle:
+			on, _ := option.Value.(bool)
+			value = on
+		case OptionTypeKeyboard:
+			value = fmt.Sprintf("%v", option.Value)
+		default:
+			value = option.Value
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export option %q: %w", item.Item.Text, err)
+		}
+
+		exported[item.Item.Text] = exportedOption{Type: optionTypeNames[option.Type], Value: raw}
+	}
+
+	return json.MarshalIndent(exported, "", "  ")
+}
+
+// ImportOptions applies values saved by ExportOptions to items, matching them by Item.Text, and returns the updated copy.
+// items itself is left untouched. Items missing from data, or whose type no longer matches, keep their current value.
+// OnUpdate callbacks are not called.
+func ImportOptions(data []byte, items []ItemWithOptions) ([]ItemWithOptions, error) {
+	var exported map[string]exportedOption
+	if err := json.Unmarshal(data, &exported); err != nil {
+		return nil, fmt.Errorf("failed to parse options: %w", err)
+	}
+
+	imported := make([]ItemWithOptions, len(items))
+	copy(imported, items)
+
+	for i := range imported {
+		item := &imported[i]
+		if item.sectionHeader || len(item.Options) == 0 || item.SelectedOption >= len(item.Options) {
+			continue
+		}
+
+		entry, ok := exported[item.Item.Text]
+		if !ok || entry.Type != optionTypeNames[item.Options[item.SelectedOption].Type] {
+			continue
+		}
+
+		item.Options = append([]Option(nil), item.Options...)
+		importOption(item, entry.Value)
+	}
+
+	return imported, nil
+}
+
+// importOption decodes a saved value into the item's selected option. Values that fail to decode are ignored.
+func importOption(item *ItemWithOptions, raw json.RawMessage) {
+	option := &item.Options[item.SelectedOption]
+
+	switch option.Type {
+	case OptionTypeKeyboard:
+		var text string
+		if json.Unmarshal(raw, &text) == nil {
+			option.Value = text
+			option.DisplayName = text
+			option.KeyboardPrompt = text
+		}
+	case OptionTypeColorPicker:
+		var hex string
+		var color sdl.Color
+		if json.Unmarshal(raw, &hex) == nil && len(hex) == 9 {
+			if _, err := fmt.Sscanf(hex, "#%02X%02X%02X%02X", &color.R, &color.G, &color.B, &color.A); err == nil {
+				option.Value = color
+				option.DisplayName = fmt.Sprintf("#%02X%02X%02X", color.R, color.G, color.B)
+			}
+		}
+	case OptionTypeSlider:
+		var value float64
+		if json.Unmarshal(raw, &value) == nil {
+			option.Value = value
+		}
+	case OptionTypeSpinner:
+		var value int
+		if json.Unmarshal(raw, &value) == nil {
+			option.Value = value
+		}
+	case OptionTypeToggle:
+		var on bool
+		if json.Unmarshal(raw, &on) == nil {
+			option.Value = on
+		}
+	default:
+		// Pick the option whose value encodes the same way as the saved one
+		for j := range item.Options {
+			if encoded, err := json.Marshal(item.Options[j].Value); err == nil && jsonEqual(encoded, raw) {
+				item.SelectedOption = j
+				break
+			}
+		}
+	}
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
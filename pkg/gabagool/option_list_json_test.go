@@ -0,0 +1,89 @@
+package gabagool
+
+import (
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// testSettings returns one item of each option type, set to the given values.
+func testSettings(quality string, name string, color sdl.Color, volume float64, count int, enabled bool) []ItemWithOptions {
+	qualityOptions := []Option{
+		{DisplayName: "Low", Value: "low"},
+		{DisplayName: "High", Value: "high"},
+	}
+	selected := 0
+	for i, option := range qualityOptions {
+		if option.Value == quality {
+			selected = i
+		}
+	}
+
+	return []ItemWithOptions{
+		{Item: MenuItem{Text: "Quality"}, Options: qualityOptions, SelectedOption: selected},
+		{Item: MenuItem{Text: "Name"}, Options: []Option{{DisplayName: name, Value: name, Type: OptionTypeKeyboard}}},
+		{Item: MenuItem{Text: "Color"}, Options: []Option{{Value: color, Type: OptionTypeColorPicker}}},
+		{Item: MenuItem{Text: "Volume"}, Options: []Option{{Value: volume, Type: OptionTypeSlider}}},
+		{Item: MenuItem{Text: "Count"}, Options: []Option{{Value: count, Type: OptionTypeSpinner}}},
+		{Item: MenuItem{Text: "Enabled"}, Options: []Option{{Value: enabled, Type: OptionTypeToggle}}},
+	}
+}
+
+func TestOptionsRoundTrip(t *testing.T) {
+	saved := testSettings("high", "Player 1", sdl.Color{R: 0x12, G: 0x34, B: 0x56, A: 0x78}, 0.75, 4, true)
+	defaults := testSettings("low", "", sdl.Color{}, 0, 0, false)
+
+	data, err := ExportOptions(saved)
+	if err != nil {
+		t.Fatalf("ExportOptions: %v", err)
+	}
+
+	imported, err := ImportOptions(data, defaults)
+	if err != nil {
+		t.Fatalf("ImportOptions: %v", err)
+	}
+
+	for i := range saved {
+		if got, want := imported[i].Value(), saved[i].Value(); got != want {
+			t.Errorf("%s = %v, want %v", saved[i].Item.Text, got, want)
+		}
+	}
+	if got := imported[1].Options[0].DisplayName; got != "Player 1" {
+		t.Errorf("Name DisplayName = %q, want %q", got, "Player 1")
+	}
+
+	// The items passed in are left untouched
+	untouched := testSettings("low", "", sdl.Color{}, 0, 0, false)
+	for i := range defaults {
+		if got, want := defaults[i].Value(), untouched[i].Value(); got != want {
+			t.Errorf("ImportOptions changed %s in the input to %v, want %v", defaults[i].Item.Text, got, want)
+		}
+	}
+}
+
+func TestImportOptionsSkipsChangedTypes(t *testing.T) {
+	data, err := ExportOptions([]ItemWithOptions{
+		{Item: MenuItem{Text: "Volume"}, Options: []Option{{Value: 0.5, Type: OptionTypeSlider}}},
+	})
+	if err != nil {
+		t.Fatalf("ExportOptions: %v", err)
+	}
+
+	items := []ItemWithOptions{
+		{Item: MenuItem{Text: "Volume"}, Options: []Option{{Value: 3, Type: OptionTypeSpinner}}},
+	}
+	imported, err := ImportOptions(data, items)
+	if err != nil {
+		t.Fatalf("ImportOptions: %v", err)
+	}
+
+	if got := imported[0].Value(); got != "3" {
+		t.Errorf("Volume = %v, want the current value 3 to be kept", got)
+	}
+}
+
+func TestImportOptionsRejectsInvalidJSON(t *testing.T) {
+	if _, err := ImportOptions([]byte("not json"), nil); err == nil {
+		t.Error("ImportOptions accepted invalid JSON")
+	}
+}
@@ -2,6 +2,7 @@ package gabagool
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"image"
 	"image/png"
@@ -9,9 +10,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
 	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
 	"github.com/srwiley/oksvg"
 	"github.com/srwiley/rasterx"
+	"github.com/veandco/go-sdl2/gfx"
 	"github.com/veandco/go-sdl2/img"
 	"github.com/veandco/go-sdl2/sdl"
 	"go.uber.org/atomic"
@@ -24,10 +27,42 @@ type ProcessMessageOptions struct {
 	ImageHeight         int32  // Desired height for rendering (required for SVG, optional for raster images)
 	ShowThemeBackground bool
 	ShowProgressBar     bool
+	SpinnerMode         bool // Shows a spinning arc in place of the progress bar when ShowProgressBar is false
 	Progress            *atomic.Float64
 	ProcessInput        bool // If true, process input events (enables chord/sequence detection)
+
+	// StallTimeout enables stall detection when Progress is set. If Progress does not change
+	// for this long, a note is shown and the user may press B to stop waiting (returns ErrCancelled).
+	// The function keeps running in the background after a cancel, though ProcessMessageWithContext
+	// cancels its context.
+	StallTimeout time.Duration
+	StallMessage string // Shown when stalled (default: "Still working...")
+
+	// CompletionDelay is how long the screen lingers after the function finishes (default: 350ms).
+	CompletionDelay time.Duration
+	// ShowCountdown shows "Continuing in N…" during the completion delay.
+	// The user may press B to stay on the screen, then A to continue.
+	ShowCountdown bool
+
+	// Stages names the steps of a multi-step process. While CurrentStage is set, the message is replaced by
+	// the current stage's name (when not empty) with "Step N of M" above it, and the progress bar spans all
+	// stages, with Progress tracking the current one.
+	Stages       []string
+	CurrentStage *atomic.Int32 // Index into Stages, advanced by the caller's function
+
+	// Cancellable lets the user press CancelButton (default: B) while the function runs. The context passed to
+	// ProcessMessageWithContext is cancelled and ErrCancelled is returned without waiting for the function.
+	Cancellable  bool
+	CancelButton constants.VirtualButton
+
+	// LogLines streams output from the function, such as the names of packages being installed. It stores a
+	// []string that the function replaces as lines arrive; the most recent lines are shown below the message.
+	LogLines *atomic.Value
 }
 
+// processLogVisibleLines is how many of the most recent LogLines are shown.
+const processLogVisibleLines = 6
+
 type processMessage struct {
 	window          *internal.Window
 	showBG          bool
@@ -35,10 +70,36 @@ type processMessage struct {
 	isProcessing    bool
 	completeTime    time.Time
 	imageTexture    *sdl.Texture
+	animation       *animatedImage
 	imageWidth      int32
 	imageHeight     int32
 	showProgressBar bool
+	spinnerMode     bool
 	progress        *atomic.Float64
+
+	stallTimeout       time.Duration
+	stallMessage       string
+	stalled            bool
+	lastProgress       float64
+	lastProgressChange time.Time
+
+	completionDelay time.Duration
+	showCountdown   bool
+	countdownHeld   bool
+
+	stages       []string
+	currentStage *atomic.Int32
+
+	cancellable  bool
+	cancelButton constants.VirtualButton
+
+	logLines *atomic.Value
+
+	// staticLayer holds the background, still image and message, drawn once and copied each frame.
+	// It is redrawn when the stage or window size changes.
+	staticLayer *sdl.Texture
+	staticStage int
+	staticSize  sdl.Point
 }
 
 // ProcessMessage displays a message while executing a function asynchronously.
@@ -47,6 +108,14 @@ type processMessage struct {
 // Supports displaying images in PNG, JPEG, and SVG formats via ImageBytes or Image (legacy).
 // For SVG images, ImageWidth and ImageHeight should be specified for optimal rendering quality.
 func ProcessMessage[T any](message string, options ProcessMessageOptions, fn func() (T, error)) (T, error) {
+	return ProcessMessageWithContext(message, options, func(context.Context) (T, error) {
+		return fn()
+	})
+}
+
+// ProcessMessageWithContext is ProcessMessage for functions that can stop early. The context is cancelled
+// when the user cancels (see Cancellable and StallTimeout) or the window closes.
+func ProcessMessageWithContext[T any](message string, options ProcessMessageOptions, fn func(ctx context.Context) (T, error)) (T, error) {
 	processor := &processMessage{
 		window:          internal.GetWindow(),
 		showBG:          options.ShowThemeBackground,
@@ -55,18 +124,62 @@ func ProcessMessage[T any](message string, options ProcessMessageOptions, fn fun
 		message:         message,
 		isProcessing:    true,
 		showProgressBar: options.ShowProgressBar,
+		spinnerMode:     options.SpinnerMode,
 		progress:        options.Progress,
+		stallTimeout:    options.StallTimeout,
+		stallMessage:    options.StallMessage,
+		completionDelay: options.CompletionDelay,
+		showCountdown:   options.ShowCountdown,
+		stages:          options.Stages,
+		currentStage:    options.CurrentStage,
+		cancellable:     options.Cancellable,
+		cancelButton:    options.CancelButton,
+		logLines:        options.LogLines,
+	}
+
+	if processor.cancelButton == constants.VirtualButtonUnassigned {
+		processor.cancelButton = constants.VirtualButtonB
+	}
+
+	if processor.completionDelay <= 0 {
+		processor.completionDelay = 350 * time.Millisecond
+	}
+
+	if processor.stallMessage == "" {
+		processor.stallMessage = "Still working..."
+	}
+
+	if processor.progress != nil {
+		processor.lastProgress = processor.progress.Load()
 	}
+	processor.lastProgressChange = time.Now()
 
 	// Load image from bytes (preferred) or from file path (legacy)
 	if len(options.ImageBytes) > 0 {
-		texture, err := loadImageTexture(processor.window.Renderer, options.ImageBytes, options.ImageWidth, options.ImageHeight)
-		if err == nil {
-			processor.imageTexture = texture
+		if isGIF(options.ImageBytes) {
+			if anim, err := loadAnimatedGIF(processor.window.Renderer, options.ImageBytes); err == nil {
+				processor.animation = anim
+			}
+		}
+
+		if processor.animation == nil {
+			texture, err := loadImageTexture(processor.window.Renderer, options.ImageBytes, options.ImageWidth, options.ImageHeight)
+			if err == nil {
+				processor.imageTexture = texture
+			}
 		}
 	} else if options.Image != "" {
 		// Legacy file path support
-		if strings.HasSuffix(strings.ToLower(options.Image), ".svg") {
+		lowerPath := strings.ToLower(options.Image)
+		if strings.HasSuffix(lowerPath, ".gif") {
+			if gifData, err := os.ReadFile(options.Image); err == nil {
+				if anim, err := loadAnimatedGIF(processor.window.Renderer, gifData); err == nil {
+					processor.animation = anim
+				}
+			}
+		}
+
+		if processor.animation == nil && strings.HasSuffix(lowerPath, ".svg") {
 			// Read SVG file
 			svgData, err := os.ReadFile(options.Image)
 			if err == nil {
@@ -75,8 +188,8 @@ func ProcessMessage[T any](message string, options ProcessMessageOptions, fn fun
 					processor.imageTexture = texture
 				}
 			}
-		} else {
-			// Load raster image
+		} else if processor.animation == nil {
+			// Load raster image (first frame only if GIF decoding failed)
 			img.Init(img.INIT_PNG | img.INIT_JPG)
 			texture, err := img.LoadTexture(processor.window.Renderer, options.Image)
 			if err == nil {
@@ -92,15 +205,18 @@ func ProcessMessage[T any](message string, options ProcessMessageOptions, fn fun
 	renderer := window.Renderer
 
 	processor.render(renderer)
-	renderer.Present()
+	window.Present()
 
 	resultChan := make(chan struct {
 		result T
 		err    error
 	}, 1)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	go func() {
-		res, err := fn()
+		res, err := fn(ctx)
 		resultChan <- struct {
 			result T
 			err    error
@@ -109,45 +225,77 @@ func ProcessMessage[T any](message string, options ProcessMessageOptions, fn fun
 
 	running := true
 	functionComplete := false
+	cancelled := false
 	var quitErr error
 
 	for running {
-		if event := sdl.WaitEventTimeout(16); event != nil {
+		if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
 			switch event.(type) {
 			case *sdl.QuitEvent:
 				running = false
 				quitErr = sdl.GetError()
-			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent:
-				if options.ProcessInput {
-					internal.GetInputProcessor().ProcessSDLEvent(event)
+			case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
+				countingDown := functionComplete && processor.showCountdown
+				cancellable := !functionComplete && processor.cancellable
+				if options.ProcessInput || processor.stalled || countingDown || cancellable {
+					inputEvent := internal.GetInputProcessor().ProcessSDLEvent(event)
+					if inputEvent != nil && inputEvent.Pressed {
+						if processor.stalled && inputEvent.Button == constants.VirtualButtonB {
+							running = false
+							cancelled = true
+						} else if cancellable && inputEvent.Button == processor.cancelButton {
+							running = false
+							cancelled = true
+						} else if countingDown && processor.countdownHeld && inputEvent.Button == constants.VirtualButtonA {
+							running = false
+						} else if countingDown && inputEvent.Button == constants.VirtualButtonB {
+							processor.countdownHeld = true
+						}
+					}
 				}
 			}
 		}
 
 		if !functionComplete {
+			processor.updateStall()
+
 			select {
 			case processResult := <-resultChan:
 				result = processResult.result
 				fnError = processResult.err
 				functionComplete = true
 				processor.isProcessing = false
+				processor.stalled = false
 				processor.completeTime = time.Now()
 			default:
 			}
 		} else {
-			if time.Since(processor.completeTime) > 350*time.Millisecond {
+			if !processor.countdownHeld && time.Since(processor.completeTime) > processor.completionDelay {
 				running = false
 			}
 		}
 
 		processor.render(renderer)
-		renderer.Present()
+		internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: -1, TextLength: -1})
+		window.Present()
 	}
 
 	if processor.imageTexture != nil {
 		processor.imageTexture.Destroy()
 	}
 
+	if processor.animation != nil {
+		processor.animation.destroy()
+	}
+
+	if processor.staticLayer != nil {
+		processor.staticLayer.Destroy()
+	}
+
+	if cancelled {
+		return result, ErrCancelled
+	}
+
 	// Prioritize function error over quit error
 	if fnError != nil {
 		return result, fnError
@@ -161,49 +309,267 @@ func ProcessMessage[T any](message string, options ProcessMessageOptions, fn fun
 }
 
 func (p *processMessage) render(renderer *sdl.Renderer) {
+	messageY, spacing := p.messageLayout()
+	font := internal.Fonts.SmallFont
 
-	if p.showBG && internal.GetWindow().Background != nil {
-		internal.GetWindow().RenderBackground()
+	if p.animation != nil {
+		// The animation sits between the background and the message, so only the background is kept
+		p.renderBackground(renderer)
+		p.animation.update()
+		p.renderImage(renderer, p.animation.texture())
+		p.renderMessage(renderer, messageY, spacing)
 	} else {
-		renderer.SetDrawColor(0, 0, 0, 255)
-		renderer.Clear()
+		p.renderStaticLayer(renderer, messageY, spacing)
 	}
 
-	if p.imageTexture != nil {
-		width := p.imageWidth
-		height := p.imageHeight
-
-		if width == 0 {
-			width = p.window.GetWidth()
-		}
+	if p.showProgressBar {
+		p.renderProgressBar(renderer, messageY, spacing)
+	} else if p.showSpinner() {
+		p.renderSpinner(renderer, messageY, spacing)
+	}
 
-		if height == 0 {
-			height = p.window.GetHeight()
-		}
+	if p.stalled {
+		p.renderStallNotice(renderer, messageY, spacing)
+	} else if p.cancellable && p.isProcessing {
+		renderFooter(renderer, font, []FooterHelpItem{
+			{ButtonName: p.cancelButton.GetName(), HelpText: "Cancel"},
+		}, defaultFooterMargins(), true, true)
+	}
 
-		x := (p.window.GetWidth() - width) / 2
-		y := (p.window.GetHeight() - height) / 2
+	if p.showCountdown && !p.isProcessing {
+		p.renderCountdown(renderer, messageY, spacing)
+	}
 
-		renderer.Copy(p.imageTexture, nil, &sdl.Rect{X: x, Y: y, W: width, H: height})
+	if p.logLines != nil {
+		p.renderLog(renderer, p.noticeY(messageY, spacing)+int32(font.Height())+spacing*2)
 	}
+}
 
+// messageLayout returns the Y of the message and the spacing between the lines around it.
+func (p *processMessage) messageLayout() (int32, int32) {
 	font := internal.Fonts.SmallFont
 
-	maxWidth := p.window.GetWidth() * 3 / 4
-
 	messageY := p.window.GetHeight() / 2
 	spacing := int32(5)
-	if p.showProgressBar {
+	if p.showProgressBar || p.showSpinner() {
 		barHeight := int32(40)
 		totalHeight := (int32(font.Height()) * 2) + spacing + barHeight
 		messageY = (p.window.GetHeight() - totalHeight) / 2
 	}
+	if p.logLines != nil {
+		// Make room for the log below the notice line
+		messageY -= (p.logAreaHeight() + int32(font.Height()) + spacing*2) / 2
+	}
+	return messageY, spacing
+}
 
-	internal.RenderMultilineText(renderer, p.message, font, maxWidth, p.window.GetWidth()/2, messageY, sdl.Color{R: 255, G: 255, B: 255, A: 255})
+// renderStaticLayer copies the pre-rendered background, image and message, redrawing them first if the stage
+// or window size changed. If the layer can't be created they are drawn directly.
+func (p *processMessage) renderStaticLayer(renderer *sdl.Renderer, messageY, spacing int32) {
+	stage, _ := p.stage()
+	size := sdl.Point{X: p.window.GetWidth(), Y: p.window.GetHeight()}
 
-	if p.showProgressBar {
-		p.renderProgressBar(renderer, messageY, spacing)
+	if p.staticLayer != nil && (stage != p.staticStage || size != p.staticSize) {
+		p.staticLayer.Destroy()
+		p.staticLayer = nil
+	}
+
+	if p.staticLayer == nil {
+		layer, err := internal.RenderToTexture(renderer, size.X, size.Y, func(renderer *sdl.Renderer) {
+			p.renderBackground(renderer)
+			p.renderImage(renderer, p.imageTexture)
+			p.renderMessage(renderer, messageY, spacing)
+		})
+		if err != nil {
+			p.renderBackground(renderer)
+			p.renderImage(renderer, p.imageTexture)
+			p.renderMessage(renderer, messageY, spacing)
+			return
+		}
+
+		p.staticLayer = layer
+		p.staticStage = stage
+		p.staticSize = size
+	}
+
+	renderer.SetDrawColor(0, 0, 0, 255)
+	renderer.Clear()
+	renderer.Copy(p.staticLayer, nil, &sdl.Rect{X: 0, Y: 0, W: size.X, H: size.Y})
+}
+
+func (p *processMessage) renderBackground(renderer *sdl.Renderer) {
+	if p.showBG && p.window.HasBackground() {
+		p.window.RenderBackground()
+	} else {
+		renderer.SetDrawColor(0, 0, 0, 255)
+		renderer.Clear()
+	}
+}
+
+func (p *processMessage) renderImage(renderer *sdl.Renderer, imageTexture *sdl.Texture) {
+	if imageTexture == nil {
+		return
+	}
+
+	width := p.imageWidth
+	height := p.imageHeight
+
+	if width == 0 {
+		width = p.window.GetWidth()
+	}
+
+	if height == 0 {
+		height = p.window.GetHeight()
+	}
+
+	x := (p.window.GetWidth() - width) / 2
+	y := (p.window.GetHeight() - height) / 2
+
+	renderer.Copy(imageTexture, nil, &sdl.Rect{X: x, Y: y, W: width, H: height})
+}
+
+// renderMessage draws the message, or the current stage's name with "Step N of M" above it.
+func (p *processMessage) renderMessage(renderer *sdl.Renderer, messageY, spacing int32) {
+	font := internal.Fonts.SmallFont
+	maxWidth := p.window.GetWidth() * 3 / 4
+
+	message := p.message
+	if stage, ok := p.stage(); ok {
+		if p.stages[stage] != "" {
+			message = p.stages[stage]
+		}
+
+		stepText := fmt.Sprintf("Step %d of %d", stage+1, len(p.stages))
+		stepY := messageY - int32(font.Height()) - spacing
+		internal.RenderMultilineText(renderer, stepText, font, maxWidth, p.window.GetWidth()/2, stepY, sdl.Color{R: 180, G: 180, B: 180, A: 255})
+	}
+
+	internal.RenderMultilineText(renderer, message, font, maxWidth, p.window.GetWidth()/2, messageY, sdl.Color{R: 255, G: 255, B: 255, A: 255})
+}
+
+// noticeY is where the stall and countdown notices go, below the message and any progress indicator.
+func (p *processMessage) noticeY(messageY, spacing int32) int32 {
+	noticeY := messageY + int32(internal.Fonts.SmallFont.Height())*2 + spacing
+	if p.showProgressBar || p.showSpinner() {
+		noticeY += int32(40) + spacing
+	}
+	return noticeY
+}
+
+func (p *processMessage) logAreaHeight() int32 {
+	padding := int32(float32(10) * internal.GetScaleFactor())
+	return int32(internal.Fonts.TinyFont.Height())*processLogVisibleLines + padding*2
+}
+
+// renderLog draws the most recent log lines in a fixed-height box, so older lines scroll off the top.
+func (p *processMessage) renderLog(renderer *sdl.Renderer, y int32) {
+	lines, _ := p.logLines.Load().([]string)
+
+	font := internal.Fonts.TinyFont
+	padding := int32(float32(10) * internal.GetScaleFactor())
+	width := p.window.GetWidth() * 3 / 4
+	box := sdl.Rect{X: (p.window.GetWidth() - width) / 2, Y: y, W: width, H: p.logAreaHeight()}
+	internal.DrawRoundedRect(renderer, &box, padding, internal.GetTheme().CardBackground)
+
+	if len(lines) > processLogVisibleLines {
+		lines = lines[len(lines)-processLogVisibleLines:]
+	}
+
+	lineY := box.Y + padding
+	for _, line := range lines {
+		if texture := renderText(renderer, line, font, sdl.Color{R: 180, G: 180, B: 180, A: 255}); texture != nil {
+			_, _, w, h, _ := texture.Query()
+			// Clip long lines at the edge of the box
+			src := sdl.Rect{W: internal.Min32(w, box.W-padding*2), H: h}
+			renderer.Copy(texture, &src, &sdl.Rect{X: box.X + padding, Y: lineY, W: src.W, H: h})
+			texture.Destroy()
+		}
+		lineY += int32(font.Height())
+	}
+}
+
+// stage returns the index of the current stage, clamped to Stages, or false if stages aren't in use.
+func (p *processMessage) stage() (int, bool) {
+	if p.currentStage == nil || len(p.stages) == 0 {
+		return 0, false
+	}
+
+	stage := int(p.currentStage.Load())
+	if stage < 0 {
+		stage = 0
+	} else if stage >= len(p.stages) {
+		stage = len(p.stages) - 1
+	}
+	return stage, true
+}
+
+// overallProgress is the progress shown on the bar. With stages, each stage fills an equal share of the bar.
+func (p *processMessage) overallProgress() float64 {
+	progress := 0.0
+	if p.progress != nil {
+		progress = p.progress.Load()
 	}
+
+	if stage, ok := p.stage(); ok {
+		return (float64(stage) + progress) / float64(len(p.stages))
+	}
+	return progress
+}
+
+func (p *processMessage) updateStall() {
+	if p.progress == nil || p.stallTimeout <= 0 {
+		return
+	}
+
+	current := p.progress.Load()
+	if current != p.lastProgress {
+		p.lastProgress = current
+		p.lastProgressChange = time.Now()
+		p.stalled = false
+		return
+	}
+
+	if time.Since(p.lastProgressChange) > p.stallTimeout {
+		p.stalled = true
+	}
+}
+
+func (p *processMessage) renderStallNotice(renderer *sdl.Renderer, messageY, spacing int32) {
+	font := internal.Fonts.SmallFont
+
+	noticeY := p.noticeY(messageY, spacing)
+
+	internal.RenderMultilineText(renderer, p.stallMessage, font, p.window.GetWidth()*3/4, p.window.GetWidth()/2, noticeY, sdl.Color{R: 180, G: 180, B: 180, A: 255})
+
+	renderFooter(renderer, font, []FooterHelpItem{
+		{ButtonName: "B", HelpText: "Cancel"},
+	}, defaultFooterMargins(), true, true)
+}
+
+func (p *processMessage) renderCountdown(renderer *sdl.Renderer, messageY, spacing int32) {
+	font := internal.Fonts.SmallFont
+
+	noticeY := p.noticeY(messageY, spacing)
+
+	var noticeText string
+	var footerHelpItems []FooterHelpItem
+	if p.countdownHeld {
+		footerHelpItems = []FooterHelpItem{{ButtonName: "A", HelpText: "Continue"}}
+	} else {
+		remaining := p.completionDelay - time.Since(p.completeTime)
+		seconds := int((remaining + time.Second - 1) / time.Second)
+		if seconds < 1 {
+			seconds = 1
+		}
+		noticeText = fmt.Sprintf("Continuing in %d…", seconds)
+		footerHelpItems = []FooterHelpItem{{ButtonName: "B", HelpText: "Stay"}}
+	}
+
+	if noticeText != "" {
+		internal.RenderMultilineText(renderer, noticeText, font, p.window.GetWidth()*3/4, p.window.GetWidth()/2, noticeY, sdl.Color{R: 180, G: 180, B: 180, A: 255})
+	}
+
+	renderFooter(renderer, font, footerHelpItems, defaultFooterMargins(), true, true)
 }
 
 func (p *processMessage) renderProgressBar(renderer *sdl.Renderer, messageY, spacing int32) {
@@ -225,7 +591,8 @@ func (p *processMessage) renderProgressBar(renderer *sdl.Renderer, messageY, spa
 		H: barHeight,
 	}
 
-	progressWidth := int32(float64(barWidth) * p.progress.Load())
+	progress := p.overallProgress()
+	progressWidth := int32(float64(barWidth) * progress)
 
 	// Use smooth progress bar with anti-aliased rounded edges
 	internal.DrawSmoothProgressBar(
@@ -236,7 +603,7 @@ func (p *processMessage) renderProgressBar(renderer *sdl.Renderer, messageY, spa
 		sdl.Color{R: 100, G: 150, B: 255, A: 255},
 	)
 
-	percentText := fmt.Sprintf("%.0f%%", p.progress.Load()*100)
+	percentText := fmt.Sprintf("%.0f%%", progress*100)
 
 	percentSurface, err := internal.Fonts.SmallFont.RenderUTF8Blended(percentText, sdl.Color{R: 255, G: 255, B: 255, A: 255})
 	if err == nil {
@@ -258,6 +625,26 @@ func (p *processMessage) renderProgressBar(renderer *sdl.Renderer, messageY, spa
 	}
 }
 
+func (p *processMessage) showSpinner() bool {
+	return p.spinnerMode && !p.showProgressBar
+}
+
+// renderSpinner draws an arc that turns once per second, in the space the progress bar would take.
+func (p *processMessage) renderSpinner(renderer *sdl.Renderer, messageY, spacing int32) {
+	size := int32(40)
+	centerX := p.window.GetWidth() / 2
+	centerY := messageY + int32(internal.Fonts.SmallFont.Height()) + spacing + size/2
+	radius := size / 2
+	thickness := int32(4)
+
+	angle := int32(time.Now().UnixMilli() % 1000 * 360 / 1000)
+
+	for r := radius - thickness + 1; r <= radius; r++ {
+		gfx.AACircleColor(renderer, centerX, centerY, r, sdl.Color{R: 50, G: 50, B: 50, A: 255})
+		gfx.ArcColor(renderer, centerX, centerY, r, angle, angle+90, sdl.Color{R: 100, G: 150, B: 255, A: 255})
+	}
+}
+
 // isSVG checks if the data is SVG format
 func isSVG(data []byte) bool {
 	// Check for SVG header
@@ -327,11 +714,3 @@ func loadSVGTexture(renderer *sdl.Renderer, svgData []byte, width, height int32)
 	// Load the PNG as a texture
 	return loadRasterTexture(renderer, buf.Bytes())
 }
-
-// min returns the minimum of two integers
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
@@ -0,0 +1,35 @@
+package gabagool
+
+import "github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+
+// TimestampedEvent is a recorded button event and its time since the recording started.
+type TimestampedEvent = internal.TimestampedEvent
+
+// InputRecording holds button events captured between StartRecording and StopRecording.
+type InputRecording = internal.InputRecording
+
+// StartRecording begins capturing every button event the components receive, for demos, smoke tests or tutorials.
+// A recording already in progress is discarded and returned.
+func StartRecording() InputRecording {
+	return internal.GetInputProcessor().StartRecording()
+}
+
+// StopRecording ends the current recording and returns it.
+func StopRecording() InputRecording {
+	return internal.GetInputProcessor().StopRecording()
+}
+
+// Playback feeds a recording back into the components as if the buttons were pressed again.
+// With realtime set the original timing is kept; otherwise the events are delivered as fast as the UI reads them.
+//
+// Example:
+//
+//	gabagool.StartRecording()
+//	gabagool.List(options)
+//	demo := gabagool.StopRecording()
+//
+//	gabagool.Playback(demo, true)
+//	gabagool.List(options)
+func Playback(recording InputRecording, realtime bool) {
+	internal.GetInputProcessor().Playback(recording, realtime)
+}
@@ -3,7 +3,9 @@ package gabagool
 import "errors"
 
 var (
-	ErrCancelled = errors.New("operation cancelled by user")
+	ErrCancelled        = errors.New("operation cancelled by user")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	ErrNavigatorEmpty   = errors.New("navigator has no screens")
 )
 
 type ListAction int
@@ -13,6 +15,7 @@ const (
 	ListActionTriggered
 	ListActionSecondaryTriggered
 	ListActionConfirmed
+	ListActionDiscarded // The user left with unsaved changes through the discard button
 )
 
 type DetailAction int
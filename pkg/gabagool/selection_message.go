@@ -10,6 +10,16 @@ import (
 	"github.com/veandco/go-sdl2/ttf"
 )
 
+// SelectionLayout controls how SelectionMessage arranges its options.
+type SelectionLayout int
+
+const (
+	// SelectionLayoutHorizontal shows up to three options in a row, navigated with left/right
+	SelectionLayoutHorizontal SelectionLayout = iota
+	// SelectionLayoutVertical shows the options as a scrolling list of pills, navigated with up/down
+	SelectionLayoutVertical
+)
+
 // SelectionMessageSettings configures the selection message component.
 type SelectionMessageSettings struct {
 	// ConfirmButton is the button used to confirm the selection (default: VirtualButtonA)
@@ -22,6 +32,12 @@ type SelectionMessageSettings struct {
 	InitialSelection int
 	// StatusBar configures the optional status bar in the top-right corner
 	StatusBar StatusBarOptions
+	// Layout arranges the options horizontally (default) or as a vertical list
+	Layout SelectionLayout
+	// AutoSelectAfter picks AutoSelectIndex on its own once this long passes without directional input (0 disables)
+	AutoSelectAfter time.Duration
+	// AutoSelectIndex is the index of the option chosen when AutoSelectAfter elapses
+	AutoSelectIndex int
 }
 
 // SelectionMessageResult represents the result of a selection message.
@@ -56,14 +72,22 @@ type selectionMessageController struct {
 	lastInputTime     time.Time
 	confirmed         bool
 	cancelled         bool
+	layout            SelectionLayout
+	maxVisibleRows    int
+	autoSelectAfter   time.Duration
+	autoSelectIndex   int
+	autoSelectStart   time.Time
+	backdrop          *sdl.Texture
 }
 
 const maxVisibleOptions = 3
 
-// SelectionMessage displays a message with horizontally selectable options.
-// The user can navigate options with left/right and confirm with the confirm button.
+// SelectionMessage displays a message with selectable options.
+// The user can navigate options with left/right (or up/down with SelectionLayoutVertical) and confirm with the confirm button.
 // Returns ErrCancelled if the user presses the back button.
 func SelectionMessage(message string, options []SelectionOption, footerHelpItems []FooterHelpItem, settings SelectionMessageSettings) (*SelectionMessageResult, error) {
+	defer internal.GetInputProcessor().StartCooldown()
+
 	if len(options) == 0 {
 		return nil, ErrCancelled
 	}
@@ -82,7 +106,13 @@ func SelectionMessage(message string, options []SelectionOption, footerHelpItems
 		statusBar:       settings.StatusBar,
 		inputDelay:      constants.DefaultInputDelay,
 		lastInputTime:   time.Now(),
+		layout:          settings.Layout,
+		autoSelectAfter: settings.AutoSelectAfter,
+		autoSelectIndex: settings.AutoSelectIndex,
+		autoSelectStart: time.Now(),
+		backdrop:        captureBackdrop(window),
 	}
+	defer destroyBackdrop(controller.backdrop)
 
 	if controller.confirmButton == constants.VirtualButtonUnassigned {
 		controller.confirmButton = constants.VirtualButtonA
@@ -94,8 +124,14 @@ func SelectionMessage(message string, options []SelectionOption, footerHelpItems
 	if controller.selectedIndex < 0 || controller.selectedIndex >= len(options) {
 		controller.selectedIndex = 0
 	}
+	if controller.autoSelectIndex < 0 || controller.autoSelectIndex >= len(options) {
+		controller.autoSelectIndex = 0
+	}
 
-	if len(options) >= maxVisibleOptions {
+	if controller.layout == SelectionLayoutVertical {
+		controller.maxVisibleRows = controller.calculateMaxVisibleRows(window)
+		controller.scrollTo(controller.selectedIndex)
+	} else if len(options) >= maxVisibleOptions {
 		controller.visibleStartIndex = controller.selectedIndex - maxVisibleOptions/2
 		if controller.visibleStartIndex < 0 {
 			controller.visibleStartIndex += len(options)
@@ -107,6 +143,12 @@ func SelectionMessage(message string, options []SelectionOption, footerHelpItems
 			break
 		}
 
+		if controller.autoSelectExpired() {
+			controller.selectedIndex = controller.autoSelectIndex
+			controller.confirmed = true
+			break
+		}
+
 		controller.render(renderer, window)
 	}
 
@@ -123,28 +165,49 @@ func SelectionMessage(message string, options []SelectionOption, footerHelpItems
 func (c *selectionMessageController) handleEvents() bool {
 	processor := internal.GetInputProcessor()
 
-	if event := sdl.WaitEventTimeout(16); event != nil {
+	if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
 		switch event.(type) {
 		case *sdl.QuitEvent:
 			c.cancelled = true
 			return false
 
-		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent:
+		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
 			inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
 			if inputEvent == nil || !inputEvent.Pressed {
 				return true
 			}
 
+			switch inputEvent.Button {
+			case constants.VirtualButtonUp, constants.VirtualButtonDown, constants.VirtualButtonLeft, constants.VirtualButtonRight:
+				c.autoSelectStart = time.Now()
+			}
+
 			if time.Since(c.lastInputTime) < c.inputDelay {
 				return true
 			}
 			c.lastInputTime = time.Now()
 
+			if footerButtonDisabled(c.footerHelpItems, inputEvent.Button) {
+				return true
+			}
+
 			switch inputEvent.Button {
 			case constants.VirtualButtonLeft:
-				c.navigateLeft()
+				if c.layout == SelectionLayoutHorizontal {
+					c.navigateLeft()
+				}
 			case constants.VirtualButtonRight:
-				c.navigateRight()
+				if c.layout == SelectionLayoutHorizontal {
+					c.navigateRight()
+				}
+			case constants.VirtualButtonUp:
+				if c.layout == SelectionLayoutVertical {
+					c.moveSelection(-1)
+				}
+			case constants.VirtualButtonDown:
+				if c.layout == SelectionLayoutVertical {
+					c.moveSelection(1)
+				}
 			case c.confirmButton, constants.VirtualButtonStart:
 				c.confirmed = true
 				return false
@@ -183,20 +246,74 @@ func (c *selectionMessageController) navigateRight() {
 	}
 }
 
+func (c *selectionMessageController) autoSelectExpired() bool {
+	return c.autoSelectAfter > 0 && time.Since(c.autoSelectStart) >= c.autoSelectAfter
+}
+
+// moveSelection moves through the vertical list, wrapping at either end and scrolling to keep the selection visible.
+func (c *selectionMessageController) moveSelection(delta int) {
+	c.selectedIndex = (c.selectedIndex + delta + len(c.options)) % len(c.options)
+	c.scrollTo(c.selectedIndex)
+}
+
+func (c *selectionMessageController) scrollTo(index int) {
+	if index < c.visibleStartIndex {
+		c.visibleStartIndex = index
+	} else if index >= c.visibleStartIndex+c.maxVisibleRows {
+		c.visibleStartIndex = index - c.maxVisibleRows + 1
+	}
+}
+
+func (c *selectionMessageController) rowHeight() int32 {
+	return int32(internal.Fonts.MediumFont.Height()) + int32(float32(16)*internal.GetScaleFactor())
+}
+
+// calculateMaxVisibleRows fits as many vertical rows as the space between the message and the footer allows.
+func (c *selectionMessageController) calculateMaxVisibleRows(window *internal.Window) int {
+	footerHeight := int32(float32(50)*internal.GetScaleFactor()*internal.GetFontScale()) + internal.GetSafeArea().Bottom + 20
+	messageHeight := c.maxMessageHeight(internal.Fonts.LargeFont, messageMaxWidth(window.GetWidth()))
+
+	available := window.GetHeight() - footerHeight*2 - messageHeight - 30
+	maxVisible := int(available / c.rowHeight())
+	if maxVisible < 1 {
+		maxVisible = 1
+	}
+	return maxVisible
+}
+
+// maxMessageHeight is the tallest of the message and the option descriptions, so the layout doesn't bounce
+// as descriptions swap in.
+func (c *selectionMessageController) maxMessageHeight(font *ttf.Font, maxWidth int32) int32 {
+	maxHeight := c.calculateTextHeight(c.message, font, maxWidth)
+	for _, opt := range c.options {
+		if opt.Description != "" {
+			h := c.calculateTextHeight(opt.Description, font, maxWidth)
+			if h > maxHeight {
+				maxHeight = h
+			}
+		}
+	}
+	return maxHeight
+}
+
 func (c *selectionMessageController) render(renderer *sdl.Renderer, window *internal.Window) {
-	renderer.SetDrawColor(0, 0, 0, 255)
-	renderer.Clear()
+	internal.ShowModal(renderer, func() {
+		renderBackdrop(renderer, window, c.backdrop, sdl.Color{R: 0, G: 0, B: 0, A: 255})
+	}, func() {
+		c.renderDialog(renderer, window)
+	})
+	internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: c.selectedIndex, TextLength: -1})
+	window.Present()
+}
 
+func (c *selectionMessageController) renderDialog(renderer *sdl.Renderer, window *internal.Window) {
 	windowWidth := window.GetWidth()
 	windowHeight := window.GetHeight()
 
 	messageFont := internal.Fonts.LargeFont
 	optionFont := internal.Fonts.MediumFont
 
-	maxMessageWidth := int32(float64(windowWidth) * 0.75)
-	if maxMessageWidth > 800 {
-		maxMessageWidth = 800
-	}
+	maxMessageWidth := messageMaxWidth(windowWidth)
 
 	// Determine display message (use description if selected option has one)
 	displayMessage := c.message
@@ -205,19 +322,17 @@ func (c *selectionMessageController) render(renderer *sdl.Renderer, window *inte
 	}
 
 	// Calculate max message height across all possible messages to prevent bouncing
-	maxMessageHeight := c.calculateTextHeight(c.message, messageFont, maxMessageWidth)
-	for _, opt := range c.options {
-		if opt.Description != "" {
-			h := c.calculateTextHeight(opt.Description, messageFont, maxMessageWidth)
-			if h > maxMessageHeight {
-				maxMessageHeight = h
-			}
-		}
-	}
+	maxMessageHeight := c.maxMessageHeight(messageFont, maxMessageWidth)
 
 	optionHeight := int32(optionFont.Height())
+	if c.layout == SelectionLayoutVertical {
+		optionHeight = int32(min(c.maxVisibleRows, len(c.options))) * c.rowHeight()
+	}
 	spacing := int32(30)
 	totalHeight := maxMessageHeight + spacing + optionHeight
+	if c.autoSelectAfter > 0 {
+		totalHeight += spacing + c.autoSelectBarHeight()
+	}
 
 	startY := (windowHeight - totalHeight) / 2
 
@@ -238,20 +353,27 @@ func (c *selectionMessageController) render(renderer *sdl.Renderer, window *inte
 	)
 
 	optionY := startY + maxMessageHeight + spacing
-	c.renderOptions(renderer, centerX, optionY, optionFont)
+	if c.layout == SelectionLayoutVertical {
+		c.renderVerticalOptions(renderer, windowWidth, optionY, optionFont)
+	} else {
+		c.renderOptions(renderer, centerX, optionY, optionFont)
+	}
 
-	renderStatusBar(renderer, internal.Fonts.SmallFont, c.statusBar, internal.UniformPadding(20))
+	if c.autoSelectAfter > 0 {
+		c.renderAutoSelectBar(renderer, windowWidth, optionY+optionHeight+spacing)
+	}
+
+	margins := internal.UniformPadding(20).WithSafeArea()
+	renderStatusBar(renderer, internal.Fonts.SmallFont, c.statusBar, margins)
 
 	renderFooter(
 		renderer,
 		internal.Fonts.SmallFont,
 		c.footerHelpItems,
-		20,
+		margins,
 		false,
 		true,
 	)
-
-	renderer.Present()
 }
 
 func (c *selectionMessageController) calculateTextHeight(text string, font *ttf.Font, maxWidth int32) int32 {
@@ -376,6 +498,63 @@ func (c *selectionMessageController) renderOptions(renderer *sdl.Renderer, cente
 	c.renderText(renderer, font, rightArrow, rightArrowX, y, arrowColor)
 }
 
+// renderVerticalOptions draws the visible window of options as a centered column of rows, with the selected row
+// drawn as a highlighted pill.
+func (c *selectionMessageController) renderVerticalOptions(renderer *sdl.Renderer, windowWidth, y int32, font *ttf.Font) {
+	theme := internal.GetTheme()
+	rowHeight := c.rowHeight()
+	padding := int32(float32(20) * internal.GetScaleFactor())
+
+	pillWidth := int32(0)
+	for _, opt := range c.options {
+		if w := c.getTextWidth(font, opt.DisplayName); w > pillWidth {
+			pillWidth = w
+		}
+	}
+	pillWidth = internal.Max32(pillWidth+padding*2, windowWidth*2/5)
+	pillWidth = internal.Min32(pillWidth, windowWidth*4/5)
+	pillX := (windowWidth - pillWidth) / 2
+
+	visibleCount := min(c.maxVisibleRows, len(c.options)-c.visibleStartIndex)
+	for i := 0; i < visibleCount; i++ {
+		index := c.visibleStartIndex + i
+		rowY := y + int32(i)*rowHeight
+
+		textColor := theme.TextColor
+		if index == c.selectedIndex {
+			pillRect := sdl.Rect{X: pillX, Y: rowY, W: pillWidth, H: rowHeight}
+			internal.DrawRoundedRect(renderer, &pillRect, rowHeight/2, theme.HighlightColor)
+			textColor = theme.HighlightedTextColor
+		}
+
+		text := c.options[index].DisplayName
+		textX := pillX + (pillWidth-c.getTextWidth(font, text))/2
+		c.renderText(renderer, font, text, textX, rowY+(rowHeight-int32(font.Height()))/2, textColor)
+	}
+}
+
+func (c *selectionMessageController) autoSelectBarHeight() int32 {
+	return internal.Max32(int32(float32(4)*internal.GetScaleFactor()), 2)
+}
+
+// renderAutoSelectBar draws a thin bar that drains as the auto-selection deadline approaches.
+func (c *selectionMessageController) renderAutoSelectBar(renderer *sdl.Renderer, windowWidth, y int32) {
+	height := c.autoSelectBarHeight()
+	track := sdl.Rect{X: windowWidth * 3 / 10, Y: y, W: windowWidth * 2 / 5, H: height}
+	internal.DrawRoundedRect(renderer, &track, height/2, sdl.Color{R: 60, G: 60, B: 60, A: 255})
+
+	remaining := 1 - float64(time.Since(c.autoSelectStart))/float64(c.autoSelectAfter)
+	if remaining <= 0 {
+		return
+	}
+
+	fill := track
+	fill.W = int32(float64(track.W) * remaining)
+	if fill.W > 0 {
+		internal.DrawRoundedRect(renderer, &fill, height/2, internal.GetTheme().HighlightColor)
+	}
+}
+
 func (c *selectionMessageController) getTextWidth(font *ttf.Font, text string) int32 {
 	width, _, err := font.SizeUTF8(text)
 	if err != nil {
@@ -178,33 +178,8 @@ func calculateStatusBarContentWidth(
 	return contentWidth
 }
 
-// renderStatusBar renders the status bar in the top-right corner of the component
-func renderStatusBar(
-	renderer *sdl.Renderer,
-	font *ttf.Font,
-	options StatusBarOptions,
-	margins internal.Padding,
-) {
-	if !options.Enabled {
-		return
-	}
-
-	scaleFactor := internal.GetScaleFactor()
-	window := internal.GetWindow()
-	windowWidth, _ := window.Window.GetSize()
-
-	outerPadding := int32(float32(20) * scaleFactor)
-	innerPaddingX := int32(float32(10) * scaleFactor)
-	innerPaddingY := int32(float32(6) * scaleFactor)
-	iconSpacing := int32(float32(8) * scaleFactor)
-
-	// Calculate content width (without pill padding)
-	contentWidth := calculateStatusBarContentWidth(font, options, iconSpacing)
-	if contentWidth <= 0 {
-		return
-	}
-
-	// Calculate content height from time or icons (whichever is taller)
+// calculateStatusBarContentHeight returns the height of the tallest status bar element (time or icons)
+func calculateStatusBarContentHeight(font *ttf.Font, options StatusBarOptions) int32 {
 	var contentHeight int32
 	if options.ShowTime {
 		timeText := formatCurrentTime(options.TimeFormat)
@@ -239,10 +214,57 @@ func renderStatusBar(
 		}
 	}
 
+	return contentHeight
+}
+
+// MeasureStatusBar returns the width the status bar reserves at the top-right of the screen
+// and the height from the top edge to the bottom of its pill. Returns 0, 0 when disabled.
+func MeasureStatusBar(opts StatusBarOptions) (w, h int32) {
+	font := internal.Fonts.SmallFont
+
+	w = calculateStatusBarWidth(font, opts)
+	if w == 0 {
+		return 0, 0
+	}
+
+	innerPaddingY := int32(float32(6) * internal.GetScaleFactor())
+	h = int32(20) + internal.GetSafeArea().Top + calculateStatusBarContentHeight(font, opts) + innerPaddingY*2
+
+	return w, h
+}
+
+// renderStatusBar renders the status bar in the top-right corner of the component
+func renderStatusBar(
+	renderer *sdl.Renderer,
+	font *ttf.Font,
+	options StatusBarOptions,
+	margins internal.Padding,
+) {
+	if !options.Enabled {
+		return
+	}
+
+	scaleFactor := internal.GetScaleFactor()
+	window := internal.GetWindow()
+	windowWidth := window.GetWidth()
+
+	outerPadding := int32(float32(20) * scaleFactor)
+	innerPaddingX := int32(float32(10) * scaleFactor)
+	innerPaddingY := int32(float32(6) * scaleFactor)
+	iconSpacing := int32(float32(8) * scaleFactor)
+
+	// Calculate content width (without pill padding)
+	contentWidth := calculateStatusBarContentWidth(font, options, iconSpacing)
+	if contentWidth <= 0 {
+		return
+	}
+
+	contentHeight := calculateStatusBarContentHeight(font, options)
+
 	pillHeight := contentHeight + (innerPaddingY * 2)
 	pillWidth := contentWidth + (innerPaddingX * 2)
 	pillX := windowWidth - margins.Right - outerPadding - pillWidth
-	pillY := int32(20) // Align with title start position
+	pillY := int32(20) + internal.GetSafeArea().Top // Align with title start position
 
 	// Draw pill background
 	pillRect := &sdl.Rect{X: pillX, Y: pillY, W: pillWidth, H: pillHeight}
@@ -262,6 +284,10 @@ func renderStatusBar(
 
 	// 2. Render icons (up to 3, right to left), vertically centered
 	// Icons render right-to-left (last icon closest to time)
+	maxIcons := 3
+	if len(options.Icons) < maxIcons {
+		maxIcons = len(options.Icons)
+	}
 	for i := maxIcons - 1; i >= 0; i-- {
 		icon := options.Icons[i]
 		currentX = renderStatusBarIcon(renderer, font, icon, currentX, contentY, contentHeight)
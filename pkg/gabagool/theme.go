@@ -0,0 +1,167 @@
+package gabagool
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// Theme holds the colors shared by every component.
+type Theme = internal.Theme
+
+// ThemeDark is the built-in dark preset: light text on a black background.
+var ThemeDark = Theme{
+	HighlightColor:       internal.HexToColor(0xFFFFFF),
+	AccentColor:          internal.HexToColor(0x2F6FDB),
+	ButtonLabelColor:     internal.HexToColor(0x1E2329),
+	TextColor:            internal.HexToColor(0xFFFFFF),
+	HighlightedTextColor: internal.HexToColor(0x000000),
+	HintColor:            internal.HexToColor(0xB4B4B4),
+	BackgroundColor:      internal.HexToColor(0x000000),
+	CardBackground:       internal.HexToColor(0x1E1E1E),
+	ShadowColor:          sdl.Color{R: 0, G: 0, B: 0, A: 160},
+}
+
+// ThemeLight is the built-in light preset: dark text on a pale background.
+var ThemeLight = Theme{
+	HighlightColor:       internal.HexToColor(0x1E2329),
+	AccentColor:          internal.HexToColor(0x2F6FDB),
+	ButtonLabelColor:     internal.HexToColor(0xFFFFFF),
+	TextColor:            internal.HexToColor(0x1E2329),
+	HighlightedTextColor: internal.HexToColor(0xFFFFFF),
+	HintColor:            internal.HexToColor(0x5A5A5A),
+	BackgroundColor:      internal.HexToColor(0xF2F2F2),
+	CardBackground:       internal.HexToColor(0xFFFFFF),
+	ShadowColor:          sdl.Color{R: 0, G: 0, B: 0, A: 60},
+}
+
+var builtInThemes = []struct {
+	name  string
+	theme *Theme
+}{
+	{"dark", &ThemeDark},
+	{"light", &ThemeLight},
+}
+
+// SetTheme switches the theme at runtime. Components pick it up on their next frame.
+func SetTheme(theme Theme) {
+	background := internal.GetTheme().BackgroundImagePath
+	internal.SetTheme(theme)
+
+	if internal.GetWindow() != nil && theme.BackgroundImagePath != background {
+		internal.ResetBackground()
+	}
+}
+
+// GetTheme returns the current theme.
+func GetTheme() Theme {
+	return internal.GetTheme()
+}
+
+// HighContrastTheme returns the theme used by high-contrast mode: white text on pure black, with bright
+// yellow and cyan for selections and accents. It can also be applied directly with SetTheme.
+func HighContrastTheme() Theme {
+	return internal.HighContrastTheme()
+}
+
+// EnableHighContrast forces HighContrastTheme on every component, ignoring the current theme and any
+// ThemeOverride, and hides background images. Disabling it brings the current theme back.
+func EnableHighContrast(enabled bool) {
+	internal.SetHighContrast(enabled)
+}
+
+// GetAvailableThemes returns the names of the built-in themes, for use with ThemeByName.
+func GetAvailableThemes() []string {
+	names := make([]string, 0, len(builtInThemes))
+	for _, t := range builtInThemes {
+		names = append(names, t.name)
+	}
+	return names
+}
+
+// ThemeByName returns the built-in theme with the given name.
+func ThemeByName(name string) (Theme, bool) {
+	for _, t := range builtInThemes {
+		if strings.EqualFold(t.name, name) {
+			return *t.theme, true
+		}
+	}
+	return Theme{}, false
+}
+
+// ThemeFromJSON parses a custom theme. Colors are "#RRGGBB" or "#RRGGBBAA" strings, and any color left out
+// is taken from ThemeDark:
+//
+//	{"highlight_color": "#FFFFFF", "accent_color": "#9B2257", "background_image_path": "/mnt/SDCARD/bg.png"}
+func ThemeFromJSON(data []byte) (Theme, error) {
+	var raw struct {
+		HighlightColor       string `json:"highlight_color"`
+		AccentColor          string `json:"accent_color"`
+		ButtonLabelColor     string `json:"button_label_color"`
+		TextColor            string `json:"text_color"`
+		HighlightedTextColor string `json:"highlighted_text_color"`
+		HintColor            string `json:"hint_color"`
+		BackgroundColor      string `json:"background_color"`
+		CardBackground       string `json:"card_background"`
+		ShadowColor          string `json:"shadow_color"`
+		FontPath             string `json:"font_path"`
+		BackgroundImagePath  string `json:"background_image_path"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Theme{}, fmt.Errorf("failed to parse theme: %w", err)
+	}
+
+	theme := ThemeDark
+	theme.FontPath = raw.FontPath
+	theme.BackgroundImagePath = raw.BackgroundImagePath
+
+	colors := []struct {
+		name  string
+		value string
+		dst   *sdl.Color
+	}{
+		{"highlight_color", raw.HighlightColor, &theme.HighlightColor},
+		{"accent_color", raw.AccentColor, &theme.AccentColor},
+		{"button_label_color", raw.ButtonLabelColor, &theme.ButtonLabelColor},
+		{"text_color", raw.TextColor, &theme.TextColor},
+		{"highlighted_text_color", raw.HighlightedTextColor, &theme.HighlightedTextColor},
+		{"hint_color", raw.HintColor, &theme.HintColor},
+		{"background_color", raw.BackgroundColor, &theme.BackgroundColor},
+		{"card_background", raw.CardBackground, &theme.CardBackground},
+		{"shadow_color", raw.ShadowColor, &theme.ShadowColor},
+	}
+
+	for _, c := range colors {
+		if c.value == "" {
+			continue
+		}
+		color, err := parseThemeColor(c.value)
+		if err != nil {
+			return Theme{}, fmt.Errorf("invalid %s: %w", c.name, err)
+		}
+		*c.dst = color
+	}
+
+	return theme, nil
+}
+
+func parseThemeColor(s string) (sdl.Color, error) {
+	hex := strings.TrimPrefix(strings.TrimPrefix(s, "#"), "0x")
+	if len(hex) != 6 && len(hex) != 8 {
+		return sdl.Color{}, fmt.Errorf("%q is not #RRGGBB or #RRGGBBAA", s)
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return sdl.Color{}, fmt.Errorf("%q is not #RRGGBB or #RRGGBBAA", s)
+	}
+
+	if len(hex) == 6 {
+		return internal.HexToColor(uint32(value)), nil
+	}
+	return sdl.Color{R: uint8(value >> 24), G: uint8(value >> 16), B: uint8(value >> 8), A: uint8(value)}, nil
+}
@@ -0,0 +1,157 @@
+package gabagool
+
+import (
+	"time"
+
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// ThreeOptionResult represents the result of a three-option message.
+type ThreeOptionResult struct {
+	// Choice is the index of the chosen option: 0, 1 or 2
+	Choice int
+}
+
+type threeOptionController struct {
+	settings      confirmationMessageSettings
+	options       [3]string
+	selectedIndex int
+	lastInputTime time.Time
+	cancelled     bool
+}
+
+// ThreeOptionMessage displays a message with three options, such as "Save", "Discard" and "Keep Editing".
+// The user cycles between the options with left/right and chooses with the confirm button.
+// Returns ErrCancelled if the user presses the cancel button.
+func ThreeOptionMessage(message string, optionA, optionB, optionC string, footerHelpItems []FooterHelpItem, options MessageOptions) (*ThreeOptionResult, error) {
+	defer internal.GetInputProcessor().StartCooldown()
+
+	window := internal.GetWindow()
+	renderer := window.Renderer
+
+	c := &threeOptionController{
+		settings:      messageSettingsFromOptions(window, message, footerHelpItems, options),
+		options:       [3]string{optionA, optionB, optionC},
+		lastInputTime: time.Now(),
+	}
+	c.settings.Backdrop = captureBackdrop(window)
+	defer destroyBackdrop(c.settings.Backdrop)
+
+	imageTexture, imageRect := loadAndPrepareImage(renderer, c.settings)
+	defer func() {
+		if imageTexture != nil {
+			imageTexture.Destroy()
+		}
+	}()
+
+	for {
+		if !c.handleEvents() {
+			break
+		}
+
+		c.render(renderer, window, imageTexture, imageRect)
+	}
+
+	if c.cancelled {
+		return nil, ErrCancelled
+	}
+	return &ThreeOptionResult{Choice: c.selectedIndex}, nil
+}
+
+func (c *threeOptionController) handleEvents() bool {
+	processor := internal.GetInputProcessor()
+
+	if event := sdl.WaitEventTimeout(internal.FrameTimeout()); event != nil {
+		switch event.(type) {
+		case *sdl.QuitEvent:
+			c.cancelled = true
+			return false
+
+		case *sdl.KeyboardEvent, *sdl.ControllerButtonEvent, *sdl.ControllerAxisEvent, *sdl.JoyButtonEvent, *sdl.JoyAxisEvent, *sdl.JoyHatEvent, *sdl.ControllerDeviceEvent, *sdl.MouseButtonEvent, *sdl.MouseMotionEvent, *sdl.UserEvent:
+			inputEvent := processor.ProcessSDLEvent(event.(sdl.Event))
+			if inputEvent == nil || !inputEvent.Pressed {
+				return true
+			}
+
+			if !isInputAllowed(c.lastInputTime, c.settings.InputDelay) {
+				return true
+			}
+			c.lastInputTime = time.Now()
+
+			if footerButtonDisabled(c.settings.FooterHelpItems, inputEvent.Button) {
+				return true
+			}
+
+			switch inputEvent.Button {
+			case constants.VirtualButtonLeft:
+				c.selectedIndex = (c.selectedIndex + len(c.options) - 1) % len(c.options)
+			case constants.VirtualButtonRight:
+				c.selectedIndex = (c.selectedIndex + 1) % len(c.options)
+			case c.settings.ConfirmButton, constants.VirtualButtonStart:
+				return false
+			case c.settings.CancelButton:
+				c.cancelled = true
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (c *threeOptionController) render(renderer *sdl.Renderer, window *internal.Window, imageTexture *sdl.Texture, imageRect sdl.Rect) {
+	scaleFactor := internal.GetScaleFactor()
+	font := internal.Fonts.SmallFont
+	padding := int32(float32(16) * scaleFactor)
+	gap := int32(float32(30) * scaleFactor)
+	pillHeight := int32(font.Height()) + padding
+
+	internal.ShowModal(renderer, func() {
+		renderBackdrop(renderer, window, c.settings.Backdrop, c.settings.BackgroundColor)
+	}, func() {
+		y := renderMessageBody(renderer, window, c.settings, imageTexture, imageRect, gap+pillHeight)
+		c.renderPills(renderer, window.GetWidth()/2, y+gap, pillHeight, padding)
+
+		renderMessageChrome(renderer, c.settings)
+	})
+	internal.DebugOverlay.Render(renderer, internal.DebugInfo{SelectedIndex: c.selectedIndex, TextLength: -1})
+	window.Present()
+}
+
+// renderPills draws the options as equally sized pills centered on centerX, highlighting the selected one.
+func (c *threeOptionController) renderPills(renderer *sdl.Renderer, centerX, y, pillHeight, padding int32) {
+	theme := internal.GetTheme()
+	font := internal.Fonts.SmallFont
+
+	pillWidth := int32(0)
+	for _, option := range c.options {
+		if w, _, err := font.SizeUTF8(option); err == nil && int32(w) > pillWidth {
+			pillWidth = int32(w)
+		}
+	}
+	pillWidth += padding * 2
+
+	totalWidth := pillWidth*int32(len(c.options)) + padding*int32(len(c.options)-1)
+	x := centerX - totalWidth/2
+
+	for i, option := range c.options {
+		pillRect := sdl.Rect{X: x, Y: y, W: pillWidth, H: pillHeight}
+
+		textColor := theme.TextColor
+		if i == c.selectedIndex {
+			internal.DrawRoundedRect(renderer, &pillRect, pillHeight/2, theme.HighlightColor)
+			textColor = theme.HighlightedTextColor
+		} else {
+			internal.DrawRoundedRect(renderer, &pillRect, pillHeight/2, sdl.Color{R: 50, G: 50, B: 50, A: 255})
+		}
+
+		if texture := renderText(renderer, option, font, textColor); texture != nil {
+			_, _, w, h, _ := texture.Query()
+			renderer.Copy(texture, nil, &sdl.Rect{X: x + (pillWidth-w)/2, Y: y + (pillHeight-h)/2, W: w, H: h})
+			texture.Destroy()
+		}
+
+		x += pillWidth + padding
+	}
+}
@@ -0,0 +1,21 @@
+package gabagool
+
+import (
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/constants"
+	"github.com/BrandonKowalski/gabagool/v2/pkg/gabagool/internal"
+)
+
+// RegisterTurbo makes a button auto-fire while it is held, as if it were pressed and released rateHz times a second.
+// Useful for rapid actions such as stepping through a long list or a value.
+//
+// Example:
+//
+//	gabagool.RegisterTurbo(constants.VirtualButtonR1, 10)
+func RegisterTurbo(button constants.VirtualButton, rateHz int) {
+	internal.GetInputProcessor().RegisterTurbo(button, rateHz)
+}
+
+// UnregisterTurbo stops a button from auto-firing.
+func UnregisterTurbo(button constants.VirtualButton) {
+	internal.GetInputProcessor().UnregisterTurbo(button)
+}
@@ -0,0 +1,129 @@
+package gabagool
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+// defaultVideoFrameRate is used for frame sequences when Section.FrameRate is not set
+const defaultVideoFrameRate = 15
+
+// videoState is an animatedImage looped in a video section, with the size it is drawn at.
+type videoState struct {
+	animatedImage
+	dimensions sdl.Rect
+}
+
+// loadVideo opens a short looping clip. path may be an animated GIF or a directory of numbered frame images
+// (PNG, JPEG or BMP, played in file name order). Frames are decoded as they are shown rather than up front.
+// A frameRate above 0 overrides the GIF's own frame delays; frame sequences default to defaultVideoFrameRate.
+func loadVideo(renderer *sdl.Renderer, path string, frameRate int) (*videoState, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open video: %w", err)
+	}
+
+	var video *videoState
+	if info.IsDir() {
+		video, err = loadFrameSequence(renderer, path)
+		if frameRate <= 0 {
+			frameRate = defaultVideoFrameRate
+		}
+	} else {
+		video, err = loadGIFVideo(renderer, path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if frameRate > 0 {
+		for i := range video.delays {
+			video.delays[i] = time.Second / time.Duration(frameRate)
+		}
+	}
+
+	video.lastAdvance = time.Now()
+	return video, nil
+}
+
+func loadGIFVideo(renderer *sdl.Renderer, path string) (*videoState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read video: %w", err)
+	}
+	if !isGIF(data) {
+		return nil, fmt.Errorf("unsupported video format: %s", filepath.Base(path))
+	}
+
+	animation, err := loadAnimatedGIF(renderer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &videoState{animatedImage: *animation}, nil
+}
+
+// fileFrames is a frame sequence that loads each image as it comes up, so only the frame on screen is held in
+// video memory however long the clip is.
+type fileFrames struct {
+	renderer *sdl.Renderer
+	paths    []string
+	texture  *sdl.Texture
+}
+
+func (f *fileFrames) frame(index int) (*sdl.Texture, error) {
+	texture, err := img.LoadTexture(f.renderer, f.paths[index])
+	if err != nil {
+		return nil, fmt.Errorf("failed to load video frame %s: %w", filepath.Base(f.paths[index]), err)
+	}
+
+	if f.texture != nil {
+		f.texture.Destroy()
+	}
+	f.texture = texture
+	return texture, nil
+}
+
+func (f *fileFrames) destroy() {
+	if f.texture != nil {
+		f.texture.Destroy()
+		f.texture = nil
+	}
+}
+
+func loadFrameSequence(renderer *sdl.Renderer, dir string) (*videoState, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read video frames: %w", err)
+	}
+
+	frames := &fileFrames{renderer: renderer}
+	// ReadDir returns entries sorted by file name
+	for _, entry := range entries {
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".png", ".jpg", ".jpeg", ".bmp":
+		default:
+			continue
+		}
+
+		frames.paths = append(frames.paths, filepath.Join(dir, entry.Name()))
+	}
+
+	if len(frames.paths) == 0 {
+		return nil, fmt.Errorf("no video frames found in %s", dir)
+	}
+
+	// The clip is sized by its first frame, which newAnimatedImage loads
+	animation, err := newAnimatedImage(frames, make([]time.Duration, len(frames.paths)), 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	_, _, animation.width, animation.height, _ = animation.texture().Query()
+	return &videoState{animatedImage: *animation}, nil
+}